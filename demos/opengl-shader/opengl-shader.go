@@ -83,6 +83,14 @@ func main() {
 	sdl.Init(sdl.InitEverything)
 	defer sdl.Quit()
 
+	// The triangle is drawn with depth testing, so ask for a depth buffer.
+	if err := sdl.GLSetAttribute(sdl.GLDepthSize, 24); err != nil {
+		panic(err)
+	}
+	if err := sdl.GLSetAttribute(sdl.GLDoubleBuffer, 1); err != nil {
+		panic(err)
+	}
+
 	window, err := sdl.NewWindow(
 		"Hello world!",
 		sdl.Rect(sdl.WindowPosCentered, sdl.WindowPosCentered, width, height),
@@ -35,21 +35,20 @@ func run() error {
 	sdl.Init(sdl.InitVideo)
 
 	surfaces, err := loadImages(flag.Args())
-	defer func() {
-		for _, s := range surfaces {
-			s.Free()
-		}
-	}()
 	if err != nil {
+		destroySurfaces(surfaces)
 		return err
 	}
 
 	_, renderer, err := openWindow(flag.Arg(0), maxSize(surfaces))
 	if err != nil {
+		destroySurfaces(surfaces)
 		return err
 	}
 
+	// The surfaces are no longer needed once they are on the GPU.
 	textures, err := convertToTextures(renderer, surfaces)
+	destroySurfaces(surfaces)
 	defer func() {
 		for _, t := range textures {
 			t.Destroy()
@@ -96,7 +95,10 @@ func mainLoop(renderer *sdl.Renderer, textures []*sdl.Texture) {
 
 		// Display image
 		renderer.Clear()
-		renderer.CopyTexture(textures[currTex], nil, nil)
+		if info, err := textures[currTex].Query(); err == nil {
+			dst := sdl.Rect(0, 0, info.Size.X, info.Size.Y)
+			renderer.CopyTexture(textures[currTex], nil, &dst)
+		}
 		renderer.Present()
 
 		// Wait a bit
@@ -141,6 +143,12 @@ func convertToTextures(renderer *sdl.Renderer, surfaces []*sdl.Surface) ([]*sdl.
 	return textures, nil
 }
 
+func destroySurfaces(surfaces []*sdl.Surface) {
+	for _, s := range surfaces {
+		s.Destroy()
+	}
+}
+
 func maxSize(s []*sdl.Surface) sdl.Point {
 	var size sdl.Point
 	for _, ss := range s {
@@ -0,0 +1,11 @@
+#include "SDL.h"
+#include "_cgo_export.h"
+
+static void audioCallback(void *userdata, Uint8 *stream, int len) {
+	goAudioCallback((uintptr_t)userdata, stream, len);
+}
+
+void setAudioCallback(SDL_AudioSpec *spec, uintptr_t id) {
+	spec->callback = audioCallback;
+	spec->userdata = (void *)id;
+}
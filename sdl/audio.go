@@ -0,0 +1,366 @@
+package sdl
+
+// #include "SDL.h"
+//
+// static int queueAudio(SDL_AudioDeviceID dev, const void *data, Uint32 len) {
+// #if SDL_VERSION_ATLEAST(2,0,4)
+// 	return SDL_QueueAudio(dev, data, len);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+//
+// static Uint32 getQueuedAudioSize(SDL_AudioDeviceID dev) {
+// #if SDL_VERSION_ATLEAST(2,0,4)
+// 	return SDL_GetQueuedAudioSize(dev);
+// #else
+// 	return 0;
+// #endif
+// }
+//
+// static void clearQueuedAudio(SDL_AudioDeviceID dev) {
+// #if SDL_VERSION_ATLEAST(2,0,4)
+// 	SDL_ClearQueuedAudio(dev);
+// #endif
+// }
+//
+// static Uint32 dequeueAudio(SDL_AudioDeviceID dev, void *data, Uint32 len) {
+// #if SDL_VERSION_ATLEAST(2,0,5)
+// 	return SDL_DequeueAudio(dev, data, len);
+// #else
+// 	return 0;
+// #endif
+// }
+import "C"
+
+import (
+	"fmt"
+	"io"
+	"time"
+	"unsafe"
+)
+
+// AudioFormat describes the data type of audio samples.
+type AudioFormat uint16
+
+// Audio formats.  LSB formats are little-endian and MSB formats are
+// big-endian.  Formats without a byte order suffix use the system's
+// byte order.
+const (
+	AudioU8     AudioFormat = C.AUDIO_U8
+	AudioS8     AudioFormat = C.AUDIO_S8
+	AudioU16LSB AudioFormat = C.AUDIO_U16LSB
+	AudioS16LSB AudioFormat = C.AUDIO_S16LSB
+	AudioU16MSB AudioFormat = C.AUDIO_U16MSB
+	AudioS16MSB AudioFormat = C.AUDIO_S16MSB
+	AudioS32LSB AudioFormat = C.AUDIO_S32LSB
+	AudioS32MSB AudioFormat = C.AUDIO_S32MSB
+	AudioF32LSB AudioFormat = C.AUDIO_F32LSB
+	AudioF32MSB AudioFormat = C.AUDIO_F32MSB
+
+	AudioU16 AudioFormat = C.AUDIO_U16SYS
+	AudioS16 AudioFormat = C.AUDIO_S16SYS
+	AudioS32 AudioFormat = C.AUDIO_S32SYS
+	AudioF32 AudioFormat = C.AUDIO_F32SYS
+)
+
+// The accessors below mirror SDL_AUDIO_MASK_BITSIZE, SDL_AUDIO_MASK_DATATYPE,
+// SDL_AUDIO_MASK_ENDIAN and SDL_AUDIO_MASK_SIGNED.
+
+// BitSize returns the number of bits in a sample.
+func (f AudioFormat) BitSize() int {
+	return int(f & 0xff)
+}
+
+// IsFloat reports whether samples are floating point.
+func (f AudioFormat) IsFloat() bool {
+	return f&(1<<8) != 0
+}
+
+// IsInt reports whether samples are integers.
+func (f AudioFormat) IsInt() bool {
+	return !f.IsFloat()
+}
+
+// IsBigEndian reports whether samples are stored big-endian.
+func (f AudioFormat) IsBigEndian() bool {
+	return f&(1<<12) != 0
+}
+
+// IsLittleEndian reports whether samples are stored little-endian.
+func (f AudioFormat) IsLittleEndian() bool {
+	return !f.IsBigEndian()
+}
+
+// IsSigned reports whether samples are signed.
+func (f AudioFormat) IsSigned() bool {
+	return f&(1<<15) != 0
+}
+
+// IsUnsigned reports whether samples are unsigned.
+func (f AudioFormat) IsUnsigned() bool {
+	return !f.IsSigned()
+}
+
+// String returns the format's name like "AudioS16LSB".
+func (f AudioFormat) String() string {
+	switch f {
+	case AudioU8:
+		return "AudioU8"
+	case AudioS8:
+		return "AudioS8"
+	case AudioU16LSB:
+		return "AudioU16LSB"
+	case AudioS16LSB:
+		return "AudioS16LSB"
+	case AudioU16MSB:
+		return "AudioU16MSB"
+	case AudioS16MSB:
+		return "AudioS16MSB"
+	case AudioS32LSB:
+		return "AudioS32LSB"
+	case AudioS32MSB:
+		return "AudioS32MSB"
+	case AudioF32LSB:
+		return "AudioF32LSB"
+	case AudioF32MSB:
+		return "AudioF32MSB"
+	default:
+		return fmt.Sprintf("AudioFormat(%#x)", uint16(f))
+	}
+}
+
+// AudioSpec describes the output format of audio data.
+type AudioSpec struct {
+	Freq     int // samples per second
+	Format   AudioFormat
+	Channels uint8  // 1 for mono, 2 for stereo, etc.
+	Samples  uint16 // buffer size in sample frames, a power of two
+
+	// Callback, if not nil, is called on SDL's audio thread whenever
+	// the device needs more data (or has recorded data, for capture
+	// devices).  It must fill the entire stream, which is not
+	// initialized, and must not retain the stream after returning.
+	// See the package documentation for the rules that apply to
+	// audio callbacks.  Devices with a callback cannot queue audio.
+	Callback func(stream []byte)
+}
+
+func (spec *AudioSpec) toCAudioSpec() C.SDL_AudioSpec {
+	return C.SDL_AudioSpec{
+		freq:     C.int(spec.Freq),
+		format:   C.SDL_AudioFormat(spec.Format),
+		channels: C.Uint8(spec.Channels),
+		samples:  C.Uint16(spec.Samples),
+	}
+}
+
+func newAudioSpec(cspec *C.SDL_AudioSpec) AudioSpec {
+	return AudioSpec{
+		Freq:     int(cspec.freq),
+		Format:   AudioFormat(cspec.format),
+		Channels: uint8(cspec.channels),
+		Samples:  uint16(cspec.samples),
+	}
+}
+
+// AudioAllowChange is a set of changes OpenAudioDevice may make to the
+// desired audio spec.
+type AudioAllowChange int
+
+// Allowed audio spec changes.  Multiple changes may be ORed together.
+const (
+	AudioAllowFrequencyChange AudioAllowChange = C.SDL_AUDIO_ALLOW_FREQUENCY_CHANGE
+	AudioAllowFormatChange    AudioAllowChange = C.SDL_AUDIO_ALLOW_FORMAT_CHANGE
+	AudioAllowChannelsChange  AudioAllowChange = C.SDL_AUDIO_ALLOW_CHANNELS_CHANGE
+	AudioAllowAnyChange       AudioAllowChange = C.SDL_AUDIO_ALLOW_ANY_CHANGE
+)
+
+// AudioStatus is the playback state of an audio device.
+type AudioStatus int
+
+// Audio device states.
+const (
+	AudioStopped AudioStatus = C.SDL_AUDIO_STOPPED
+	AudioPlaying AudioStatus = C.SDL_AUDIO_PLAYING
+	AudioPaused  AudioStatus = C.SDL_AUDIO_PAUSED
+)
+
+// String returns the status's name like "AudioPlaying".
+func (status AudioStatus) String() string {
+	switch status {
+	case AudioStopped:
+		return "AudioStopped"
+	case AudioPlaying:
+		return "AudioPlaying"
+	case AudioPaused:
+		return "AudioPaused"
+	default:
+		return fmt.Sprintf("AudioStatus(%d)", int(status))
+	}
+}
+
+// NumAudioDevices returns the number of audio output devices, or
+// capture devices if capture is true.  It returns -1 if the list of
+// devices cannot be determined; opening the default device may still
+// succeed.
+func NumAudioDevices(capture bool) int {
+	return int(C.SDL_GetNumAudioDevices(cBoolInt(capture)))
+}
+
+// AudioDeviceName returns the name of the audio device at the given
+// index, which is in the range [0, NumAudioDevices(capture)).
+func AudioDeviceName(index int, capture bool) string {
+	return C.GoString(C.SDL_GetAudioDeviceName(C.int(index), cBoolInt(capture)))
+}
+
+// An AudioDevice is an open audio device.
+type AudioDevice struct {
+	id   C.SDL_AudioDeviceID
+	spec AudioSpec
+	cbID uintptr // zero if the device has no callback
+}
+
+// OpenAudioDevice opens an audio device for playback, or recording if
+// capture is true.  An empty name opens the default device.  Devices
+// start paused.  If allowedChanges is non-zero, the device's actual
+// spec may differ from the desired spec; see the Spec method.
+func OpenAudioDevice(name string, capture bool, spec *AudioSpec, allowedChanges AudioAllowChange) (*AudioDevice, error) {
+	var cname *C.char
+	if name != "" {
+		cname = C.CString(name)
+		defer C.free(unsafe.Pointer(cname))
+	}
+	desired := spec.toCAudioSpec()
+	var cbID uintptr
+	if spec.Callback != nil {
+		cbID = registerAudioCallback(&desired, spec.Callback)
+	}
+	var obtained C.SDL_AudioSpec
+	id := C.SDL_OpenAudioDevice(cname, cBoolInt(capture), &desired, &obtained, C.int(allowedChanges))
+	if id == 0 {
+		if cbID != 0 {
+			unregisterAudioCallback(cbID)
+		}
+		return nil, GetError()
+	}
+	d := &AudioDevice{id: id, spec: newAudioSpec(&obtained), cbID: cbID}
+	d.spec.Callback = spec.Callback
+	return d, nil
+}
+
+// ID returns the device's ID, as used in AudioDeviceEvent removal events.
+func (d *AudioDevice) ID() uint32 {
+	return uint32(d.id)
+}
+
+// Spec returns the format the device was opened with.
+func (d *AudioDevice) Spec() AudioSpec {
+	return d.spec
+}
+
+// Pause pauses or resumes playback or recording.
+func (d *AudioDevice) Pause(pause bool) {
+	C.SDL_PauseAudioDevice(d.id, cBoolInt(pause))
+}
+
+// Status returns the device's playback state.
+func (d *AudioDevice) Status() AudioStatus {
+	return AudioStatus(C.SDL_GetAudioDeviceStatus(d.id))
+}
+
+// QueueAudio queues more audio data to play.  data must be in the
+// device's format.  It requires SDL 2.0.4 or later and returns an error
+// otherwise.
+func (d *AudioDevice) QueueAudio(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if C.queueAudio(d.id, unsafe.Pointer(&data[0]), C.Uint32(len(data))) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// DequeueAudio reads recorded audio from a capture device into data
+// and returns the number of bytes read.  It never blocks: if no audio
+// is available, it returns zero.  It requires SDL 2.0.5 or later and
+// always returns zero otherwise.
+func (d *AudioDevice) DequeueAudio(data []byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return int(C.dequeueAudio(d.id, unsafe.Pointer(&data[0]), C.Uint32(len(data))))
+}
+
+// QueuedAudioSize returns the number of bytes of queued audio that
+// have not yet been played, or, for capture devices, the number of
+// bytes of recorded audio available to DequeueAudio.
+func (d *AudioDevice) QueuedAudioSize() int {
+	return int(C.getQueuedAudioSize(d.id))
+}
+
+// ClearQueuedAudio drops any queued audio that has not yet been played
+// or dequeued.
+func (d *AudioDevice) ClearQueuedAudio() {
+	C.clearQueuedAudio(d.id)
+}
+
+// PlayReader plays PCM data read from r, which must be in the device's
+// format, by queueing it from a new goroutine.  The goroutine keeps
+// about two buffers of audio queued, so r is never read on the audio
+// thread.  The returned channel receives the first read or queueing
+// error, or nil at the end of the data, and is then closed.  The device
+// must not have a callback, must be unpaused to play, and should not be
+// closed until the channel is closed.
+func (d *AudioDevice) PlayReader(r io.Reader) <-chan error {
+	done := make(chan error, 1)
+	frame := frameSize(d.spec)
+	if d.cbID != 0 || frame == 0 || d.spec.Freq <= 0 {
+		done <- Error("PlayReader: device cannot queue audio")
+		close(done)
+		return done
+	}
+	samples := int(d.spec.Samples)
+	if samples == 0 {
+		samples = 4096
+	}
+	buf := make([]byte, samples*frame)
+	wait := time.Duration(samples) * time.Second / time.Duration(d.spec.Freq) / 2
+	go func() {
+		defer close(done)
+		for {
+			for d.QueuedAudioSize() >= 2*len(buf) {
+				time.Sleep(wait)
+			}
+			n, err := io.ReadFull(r, buf)
+			// Drop an incomplete sample frame at the end of the data.
+			n -= n % frame
+			if qerr := d.QueueAudio(buf[:n]); qerr != nil {
+				done <- qerr
+				return
+			}
+			if err != nil {
+				if err == io.EOF || err == io.ErrUnexpectedEOF {
+					err = nil
+				}
+				done <- err
+				return
+			}
+		}
+	}()
+	return done
+}
+
+// Close shuts down the device, waiting for any running callback to
+// return.  It is not safe to use the device after calling Close.
+// Calling Close from an audio callback panics.
+func (d *AudioDevice) Close() {
+	if inAudioCallback() {
+		panic("sdl: AudioDevice.Close called from an audio callback")
+	}
+	C.SDL_CloseAudioDevice(d.id)
+	if d.cbID != 0 {
+		unregisterAudioCallback(d.cbID)
+	}
+}
@@ -0,0 +1,139 @@
+package sdl
+
+// #include <stdint.h>
+// #include "SDL.h"
+//
+// void setAudioCallback(SDL_AudioSpec *spec, uintptr_t id);
+import "C"
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// audioCallback is a registered AudioSpec.Callback.
+type audioCallback struct {
+	// thread is the SDL thread ID of the audio thread, recorded on the
+	// first call so that Do and Close can detect misuse.  It is
+	// accessed atomically, so it must be the first field to be 64-bit
+	// aligned on 32-bit platforms.
+	thread uint64
+
+	f func(stream []byte)
+}
+
+var audioCallbacks = struct {
+	sync.RWMutex
+	m    map[uintptr]*audioCallback
+	next uintptr
+}{
+	m: make(map[uintptr]*audioCallback),
+}
+
+// registerAudioCallback adds f to the callback registry and sets up
+// cspec to call it.  It returns the registry ID.
+func registerAudioCallback(cspec *C.SDL_AudioSpec, f func(stream []byte)) uintptr {
+	audioCallbacks.Lock()
+	audioCallbacks.next++
+	id := audioCallbacks.next
+	audioCallbacks.m[id] = &audioCallback{f: f}
+	audioCallbacks.Unlock()
+	C.setAudioCallback(cspec, C.uintptr_t(id))
+	return id
+}
+
+func unregisterAudioCallback(id uintptr) {
+	audioCallbacks.Lock()
+	delete(audioCallbacks.m, id)
+	audioCallbacks.Unlock()
+}
+
+// inAudioCallback reports whether the calling thread is running an
+// audio callback.
+func inAudioCallback() bool {
+	audioCallbacks.RLock()
+	defer audioCallbacks.RUnlock()
+	if len(audioCallbacks.m) == 0 {
+		return false
+	}
+	thread := uint64(C.SDL_ThreadID())
+	for _, cb := range audioCallbacks.m {
+		if atomic.LoadUint64(&cb.thread) == thread {
+			return true
+		}
+	}
+	return false
+}
+
+//export goAudioCallback
+func goAudioCallback(id C.uintptr_t, stream *C.Uint8, n C.int) {
+	audioCallbacks.RLock()
+	cb := audioCallbacks.m[uintptr(id)]
+	audioCallbacks.RUnlock()
+	if cb == nil || n <= 0 {
+		return
+	}
+	if atomic.LoadUint64(&cb.thread) == 0 {
+		atomic.StoreUint64(&cb.thread, uint64(C.SDL_ThreadID()))
+	}
+	cb.f(unsafe.Slice((*byte)(unsafe.Pointer(stream)), int(n)))
+}
+
+// Lock prevents the device's callback from running until Unlock is
+// called.  Use it to protect data shared with the callback.
+func (d *AudioDevice) Lock() {
+	C.SDL_LockAudioDevice(d.id)
+}
+
+// Unlock allows the device's callback to run again after Lock.
+func (d *AudioDevice) Unlock() {
+	C.SDL_UnlockAudioDevice(d.id)
+}
+
+// CaptureCallback returns an audio callback for a capture device that
+// sends copies of the recorded samples on the samples channel.  The
+// copies are made into a pool of n buffers, which the callback
+// allocates on its first call so that later calls don't allocate.
+// Each buffer received from samples must be sent back on recycle once
+// it has been consumed, and only buffers received from samples may be
+// sent on recycle; sending any others may block.  If no buffer is free
+// or the samples channel is full, recorded samples are dropped rather
+// than blocking the audio thread.  CaptureCallback panics if n <= 0.
+func CaptureCallback(n int) (callback func(stream []byte), samples <-chan []byte, recycle chan<- []byte) {
+	if n <= 0 {
+		panic("sdl: CaptureCallback needs at least one buffer")
+	}
+	ch := make(chan []byte, n)
+	free := make(chan []byte, n)
+	allocated := false
+	callback = func(stream []byte) {
+		if !allocated {
+			for i := 0; i < n; i++ {
+				free <- make([]byte, len(stream))
+			}
+			allocated = true
+		}
+		var buf []byte
+		select {
+		case buf = <-free:
+		default:
+			return
+		}
+		if cap(buf) < len(stream) {
+			// The stream grew; replace the buffer to keep the pool size.
+			buf = make([]byte, len(stream))
+		}
+		buf = buf[:len(stream)]
+		copy(buf, stream)
+		select {
+		case ch <- buf:
+		default:
+			select {
+			case free <- buf:
+			default:
+			}
+		}
+	}
+	return callback, ch, free
+}
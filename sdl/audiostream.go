@@ -0,0 +1,194 @@
+package sdl
+
+// #include "SDL.h"
+//
+// // Audio streams were added in SDL 2.0.7.
+// #if !SDL_VERSION_ATLEAST(2,0,7)
+// typedef struct _SDL_AudioStream SDL_AudioStream;
+// static SDL_AudioStream *SDL_NewAudioStream(SDL_AudioFormat src_format, Uint8 src_channels, int src_rate, SDL_AudioFormat dst_format, Uint8 dst_channels, int dst_rate) {
+// 	SDL_Unsupported();
+// 	return NULL;
+// }
+// static int SDL_AudioStreamPut(SDL_AudioStream *stream, const void *buf, int len) { return SDL_Unsupported(); }
+// static int SDL_AudioStreamGet(SDL_AudioStream *stream, void *buf, int len) { return SDL_Unsupported(); }
+// static int SDL_AudioStreamAvailable(SDL_AudioStream *stream) { return 0; }
+// static int SDL_AudioStreamFlush(SDL_AudioStream *stream) { return SDL_Unsupported(); }
+// static void SDL_AudioStreamClear(SDL_AudioStream *stream) {}
+// static void SDL_FreeAudioStream(SDL_AudioStream *stream) {}
+// #endif
+import "C"
+
+import (
+	"io"
+	"unsafe"
+)
+
+// AudioStream converts audio data between formats, channel layouts and
+// sample rates as it is written and read.  It requires SDL 2.0.7 or
+// later.
+//
+// AudioStream implements io.ReadWriter.
+type AudioStream struct {
+	s *C.SDL_AudioStream
+
+	srcFrame, dstFrame int    // bytes per sample frame
+	partial            []byte // incomplete source frame buffered by Write
+}
+
+// frameSize returns the number of bytes in one sample frame of spec.
+func frameSize(spec AudioSpec) int {
+	return spec.Format.BitSize() / 8 * int(spec.Channels)
+}
+
+// NewAudioStream creates a stream that converts audio from src's format
+// to dst's format.  Only the Format, Channels and Freq fields are used.
+func NewAudioStream(src, dst AudioSpec) (*AudioStream, error) {
+	s := C.SDL_NewAudioStream(
+		C.SDL_AudioFormat(src.Format), C.Uint8(src.Channels), C.int(src.Freq),
+		C.SDL_AudioFormat(dst.Format), C.Uint8(dst.Channels), C.int(dst.Freq))
+	if s == nil {
+		return nil, GetError()
+	}
+	return &AudioStream{s: s, srcFrame: frameSize(src), dstFrame: frameSize(dst)}, nil
+}
+
+// Put adds data in the source format to the stream.  data must hold a
+// whole number of sample frames; use Write for arbitrary chunks.
+func (stream *AudioStream) Put(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if C.SDL_AudioStreamPut(stream.s, unsafe.Pointer(&data[0]), C.int(len(data))) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// Get reads converted data in the destination format into data and
+// returns the number of bytes read.  len(data) must be a whole number
+// of sample frames; use Read for arbitrary buffers.
+func (stream *AudioStream) Get(data []byte) (int, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+	n := C.SDL_AudioStreamGet(stream.s, unsafe.Pointer(&data[0]), C.int(len(data)))
+	if n < 0 {
+		return 0, GetError()
+	}
+	return int(n), nil
+}
+
+// Available returns the number of converted bytes ready to be read.
+func (stream *AudioStream) Available() int {
+	return int(C.SDL_AudioStreamAvailable(stream.s))
+}
+
+// Flush converts any data still buffered in the stream, even if it is
+// not enough to fill a complete sample frame.  Call Flush after the last
+// Put so that Get returns all of the data.  An incomplete source frame
+// buffered by Write cannot be converted and is dropped.
+func (stream *AudioStream) Flush() error {
+	stream.partial = stream.partial[:0]
+	if C.SDL_AudioStreamFlush(stream.s) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// Clear drops all data in the stream.
+func (stream *AudioStream) Clear() {
+	stream.partial = stream.partial[:0]
+	C.SDL_AudioStreamClear(stream.s)
+}
+
+// Write adds data in the source format to the stream.  It implements
+// io.Writer: p may end partway through a sample frame, in which case
+// the rest of the frame is expected in the next call.
+func (stream *AudioStream) Write(p []byte) (int, error) {
+	n := 0
+	if len(stream.partial) > 0 {
+		need := stream.srcFrame - len(stream.partial)
+		if len(p) < need {
+			stream.partial = append(stream.partial, p...)
+			return len(p), nil
+		}
+		frame := append(stream.partial, p[:need]...)
+		if err := stream.Put(frame); err != nil {
+			stream.partial = frame[:len(frame)-need]
+			return 0, err
+		}
+		stream.partial = frame[:0]
+		n, p = need, p[need:]
+	}
+	whole := len(p)
+	if stream.srcFrame > 0 {
+		whole -= len(p) % stream.srcFrame
+	}
+	if err := stream.Put(p[:whole]); err != nil {
+		return n, err
+	}
+	stream.partial = append(stream.partial, p[whole:]...)
+	return n + len(p), nil
+}
+
+// Read reads converted data in the destination format.  It implements
+// io.Reader, reading only whole sample frames and returning io.EOF when
+// no converted data is available.  More data may become available after
+// further writes.  Read returns io.ErrShortBuffer if p cannot hold a
+// single sample frame.
+func (stream *AudioStream) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if stream.dstFrame > 0 {
+		if len(p) < stream.dstFrame {
+			return 0, io.ErrShortBuffer
+		}
+		p = p[:len(p)-len(p)%stream.dstFrame]
+	}
+	n, err := stream.Get(p)
+	if err != nil {
+		return n, err
+	}
+	if n == 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// Destroy frees the stream.  The stream should not be used after
+// calling Destroy.
+func (stream *AudioStream) Destroy() {
+	C.SDL_FreeAudioStream(stream.s)
+}
+
+// ConvertAudio converts a complete buffer of audio data from src's
+// format to dst's format in one step.  Only the Format, Channels and
+// Freq fields are used.
+func ConvertAudio(src, dst AudioSpec, data []byte) ([]byte, error) {
+	var cvt C.SDL_AudioCVT
+	ret := C.SDL_BuildAudioCVT(&cvt,
+		C.SDL_AudioFormat(src.Format), C.Uint8(src.Channels), C.int(src.Freq),
+		C.SDL_AudioFormat(dst.Format), C.Uint8(dst.Channels), C.int(dst.Freq))
+	if ret < 0 {
+		return nil, GetError()
+	}
+	if ret == 0 || len(data) == 0 {
+		// No conversion needed.
+		out := make([]byte, len(data))
+		copy(out, data)
+		return out, nil
+	}
+
+	cvt.len = C.int(len(data))
+	cvt.buf = (*C.Uint8)(C.SDL_malloc(C.size_t(cvt.len * cvt.len_mult)))
+	if cvt.buf == nil {
+		return nil, Error("out of memory")
+	}
+	defer C.SDL_free(unsafe.Pointer(cvt.buf))
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(cvt.buf)), len(data)), data)
+	if C.SDL_ConvertAudio(&cvt) != 0 {
+		return nil, GetError()
+	}
+	return C.GoBytes(unsafe.Pointer(cvt.buf), cvt.len_cvt), nil
+}
@@ -0,0 +1,61 @@
+package sdl
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestAudioStreamPartialFrames(t *testing.T) {
+	spec := AudioSpec{Freq: 44100, Format: AudioS16LSB, Channels: 2}
+	stream, err := NewAudioStream(spec, spec)
+	if err != nil {
+		t.Fatal("NewAudioStream:", err)
+	}
+	defer stream.Destroy()
+
+	in := make([]byte, 4*64)
+	for i := range in {
+		in[i] = byte(i)
+	}
+	// Odd chunk sizes split sample frames at every offset.
+	for p, size := in, 1; len(p) > 0; size = (size + 2) % 8 {
+		if size > len(p) {
+			size = len(p)
+		}
+		n, err := stream.Write(p[:size])
+		if n != size || err != nil {
+			t.Fatalf("Write(%d bytes) = %d, %v; want %d, <nil>", size, n, err, size)
+		}
+		p = p[size:]
+	}
+	if err := stream.Flush(); err != nil {
+		t.Fatal("Flush:", err)
+	}
+
+	var out []byte
+	buf := make([]byte, 6)
+	for {
+		n, err := stream.Read(buf)
+		if n%4 != 0 {
+			t.Fatalf("Read returned %d bytes; want whole frames", n)
+		}
+		out = append(out, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal("Read:", err)
+		}
+	}
+	if !bytes.Equal(out, in) {
+		t.Errorf("read %x; want %x", out, in)
+	}
+
+	if err := stream.Put(in[:4]); err != nil {
+		t.Fatal("Put:", err)
+	}
+	if n, err := stream.Read(buf[:3]); n != 0 || err != io.ErrShortBuffer {
+		t.Errorf("Read(3 bytes) = %d, %v; want 0, %v", n, err, io.ErrShortBuffer)
+	}
+}
@@ -1,6 +1,21 @@
 package sdl
 
 // #include "SDL.h"
+//
+// // Audio device events were added in SDL 2.0.4.
+// #if !SDL_VERSION_ATLEAST(2,0,4)
+// #define SDL_AUDIODEVICEADDED 0x1100
+// #define SDL_AUDIODEVICEREMOVED 0x1101
+// typedef struct SDL_AudioDeviceEvent {
+// 	Uint32 type;
+// 	Uint32 timestamp;
+// 	Uint32 which;
+// 	Uint8 iscapture;
+// 	Uint8 padding1;
+// 	Uint8 padding2;
+// 	Uint8 padding3;
+// } SDL_AudioDeviceEvent;
+// #endif
 import "C"
 
 import (
@@ -101,6 +116,12 @@ const (
 	ControllerDeviceRemappedEventType EventType = C.SDL_CONTROLLERDEVICEREMAPPED
 )
 
+// Audio hotplug events
+const (
+	AudioDeviceAddedEventType   EventType = C.SDL_AUDIODEVICEADDED
+	AudioDeviceRemovedEventType EventType = C.SDL_AUDIODEVICEREMOVED
+)
+
 // Touch events
 const (
 	FingerDownEventType   EventType = C.SDL_FINGERDOWN
@@ -163,6 +184,9 @@ var eventTypeNames = map[EventType]string{
 	ControllerDeviceRemovedEventType:  "ControllerDeviceRemoved",
 	ControllerDeviceRemappedEventType: "ControllerDeviceRemapped",
 
+	AudioDeviceAddedEventType:   "AudioDeviceAdded",
+	AudioDeviceRemovedEventType: "AudioDeviceRemoved",
+
 	FingerDownEventType:   "FingerDown",
 	FingerUpEventType:     "FingerUp",
 	FingerMotionEventType: "FingerMotion",
@@ -186,6 +210,16 @@ type Event interface {
 	Timestamp() uint32
 }
 
+// WindowedEvent is implemented by events that are associated with a
+// window.  Use WindowFromID to look up the window.
+type WindowedEvent interface {
+	Event
+
+	// Window returns the ID of the window associated with the event,
+	// or zero if there is none.
+	Window() uint32
+}
+
 // PollEvent returns the next available event, or nil if there is no event pending.
 func PollEvent() Event {
 	var cEvent C.SDL_Event
@@ -325,7 +359,7 @@ func convertEvent(cEvent unsafe.Pointer) Event {
 		return &ControllerAxisEvent{
 			Time:  uint32(ce.timestamp),
 			Which: JoystickID(ce.which),
-			Axis:  uint8(ce.axis),
+			Axis:  GameControllerAxis(ce.axis),
 			Value: int16(ce.value),
 		}
 	case ControllerButtonDownEventType, ControllerButtonUpEventType:
@@ -333,7 +367,7 @@ func convertEvent(cEvent unsafe.Pointer) Event {
 		return &ControllerButtonEvent{
 			Time:    uint32(ce.timestamp),
 			Which:   JoystickID(ce.which),
-			Button:  uint8(ce.button),
+			Button:  GameControllerButton(ce.button),
 			Pressed: ce.state == C.SDL_PRESSED,
 		}
 	case ControllerDeviceAddedEventType, ControllerDeviceRemovedEventType, ControllerDeviceRemappedEventType:
@@ -343,6 +377,14 @@ func convertEvent(cEvent unsafe.Pointer) Event {
 			Time:      uint32(ce.timestamp),
 			Which:     int32(ce.which),
 		}
+	case AudioDeviceAddedEventType, AudioDeviceRemovedEventType:
+		ce := (*C.SDL_AudioDeviceEvent)(cEvent)
+		return &AudioDeviceEvent{
+			Time:    uint32(ce.timestamp),
+			Which:   uint32(ce.which),
+			Capture: ce.iscapture != 0,
+			Added:   EventType(ce._type) == AudioDeviceAddedEventType,
+		}
 	case FingerMotionEventType, FingerDownEventType, FingerUpEventType:
 		ce := (*C.SDL_TouchFingerEvent)(cEvent)
 		return &TouchFingerEvent{
@@ -555,7 +597,8 @@ func (e *TextEditingEvent) Window() uint32 {
 // one text input event.  An input method may require multiple key presses to
 // input a single character.  The text editing events allow an application to
 // render feedback of receiving the characters before inputting the final
-// character.
+// character.  Text input is enabled by default on desktop platforms;
+// see StartTextInput and StopTextInput.
 type TextInputEvent struct {
 	Time     uint32
 	WindowID uint32
@@ -601,7 +644,7 @@ func (e *MouseMotionEvent) Timestamp() uint32 {
 	return e.Time
 }
 
-// WindowID returns the window with mouse focus, or zero if no window has focus.
+// Window returns the window with mouse focus, or zero if no window has focus.
 func (e *MouseMotionEvent) Window() uint32 {
 	return e.WindowID
 }
@@ -797,7 +840,7 @@ func (e *JoyDeviceEvent) Timestamp() uint32 {
 type ControllerAxisEvent struct {
 	Time  uint32
 	Which JoystickID
-	Axis  uint8 // TODO(light): GameControllerAxis
+	Axis  GameControllerAxis
 	Value int16
 }
 
@@ -819,7 +862,7 @@ func (e *ControllerAxisEvent) Timestamp() uint32 {
 type ControllerButtonEvent struct {
 	Time    uint32
 	Which   JoystickID
-	Button  uint8 // TODO(light): GameControllerButton
+	Button  GameControllerButton
 	Pressed bool
 }
 
@@ -860,6 +903,32 @@ func (e *ControllerDeviceEvent) Timestamp() uint32 {
 
 // }}}2 ControllerDeviceEvent
 
+// {{{2 AudioDeviceEvent
+
+// AudioDeviceEvent holds an audio device connection or disconnection event.
+type AudioDeviceEvent struct {
+	Time    uint32
+	Which   uint32 // audio device index for an added event or device ID for a removal event.
+	Capture bool
+	Added   bool
+}
+
+// Type returns either AudioDeviceAddedEventType or AudioDeviceRemovedEventType.
+func (e *AudioDeviceEvent) Type() EventType {
+	if e.Added {
+		return AudioDeviceAddedEventType
+	} else {
+		return AudioDeviceRemovedEventType
+	}
+}
+
+// Timestamp returns the number of milliseconds since the SDL library initialization.
+func (e *AudioDeviceEvent) Timestamp() uint32 {
+	return e.Time
+}
+
+// }}}2 AudioDeviceEvent
+
 // {{{2 UserEvent
 
 // UserEvent holds a user-defined event.
@@ -0,0 +1,188 @@
+package sdl
+
+// #include "SDL.h"
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// GameControllerAxis is an axis on a game controller.
+type GameControllerAxis int
+
+// Game controller axes.  Thumbstick axes range from -32768 to 32767 and
+// trigger axes range from 0 to 32767.
+const (
+	ControllerAxisInvalid      GameControllerAxis = C.SDL_CONTROLLER_AXIS_INVALID
+	ControllerAxisLeftX        GameControllerAxis = C.SDL_CONTROLLER_AXIS_LEFTX
+	ControllerAxisLeftY        GameControllerAxis = C.SDL_CONTROLLER_AXIS_LEFTY
+	ControllerAxisRightX       GameControllerAxis = C.SDL_CONTROLLER_AXIS_RIGHTX
+	ControllerAxisRightY       GameControllerAxis = C.SDL_CONTROLLER_AXIS_RIGHTY
+	ControllerAxisTriggerLeft  GameControllerAxis = C.SDL_CONTROLLER_AXIS_TRIGGERLEFT
+	ControllerAxisTriggerRight GameControllerAxis = C.SDL_CONTROLLER_AXIS_TRIGGERRIGHT
+)
+
+// GameControllerAxisFromString returns the axis with the given mapping
+// name, like "leftx", or ControllerAxisInvalid if name isn't recognized.
+func GameControllerAxisFromString(name string) GameControllerAxis {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return GameControllerAxis(C.SDL_GameControllerGetAxisFromString(cname))
+}
+
+// String returns the axis's mapping name, like "leftx".
+func (axis GameControllerAxis) String() string {
+	name := C.SDL_GameControllerGetStringForAxis(C.SDL_GameControllerAxis(axis))
+	if name == nil {
+		return fmt.Sprintf("GameControllerAxis(%d)", int(axis))
+	}
+	return C.GoString(name)
+}
+
+// GameControllerButton is a button on a game controller.
+type GameControllerButton int
+
+// Game controller buttons.
+const (
+	ControllerButtonInvalid       GameControllerButton = C.SDL_CONTROLLER_BUTTON_INVALID
+	ControllerButtonA             GameControllerButton = C.SDL_CONTROLLER_BUTTON_A
+	ControllerButtonB             GameControllerButton = C.SDL_CONTROLLER_BUTTON_B
+	ControllerButtonX             GameControllerButton = C.SDL_CONTROLLER_BUTTON_X
+	ControllerButtonY             GameControllerButton = C.SDL_CONTROLLER_BUTTON_Y
+	ControllerButtonBack          GameControllerButton = C.SDL_CONTROLLER_BUTTON_BACK
+	ControllerButtonGuide         GameControllerButton = C.SDL_CONTROLLER_BUTTON_GUIDE
+	ControllerButtonStart         GameControllerButton = C.SDL_CONTROLLER_BUTTON_START
+	ControllerButtonLeftStick     GameControllerButton = C.SDL_CONTROLLER_BUTTON_LEFTSTICK
+	ControllerButtonRightStick    GameControllerButton = C.SDL_CONTROLLER_BUTTON_RIGHTSTICK
+	ControllerButtonLeftShoulder  GameControllerButton = C.SDL_CONTROLLER_BUTTON_LEFTSHOULDER
+	ControllerButtonRightShoulder GameControllerButton = C.SDL_CONTROLLER_BUTTON_RIGHTSHOULDER
+	ControllerButtonDPadUp        GameControllerButton = C.SDL_CONTROLLER_BUTTON_DPAD_UP
+	ControllerButtonDPadDown      GameControllerButton = C.SDL_CONTROLLER_BUTTON_DPAD_DOWN
+	ControllerButtonDPadLeft      GameControllerButton = C.SDL_CONTROLLER_BUTTON_DPAD_LEFT
+	ControllerButtonDPadRight     GameControllerButton = C.SDL_CONTROLLER_BUTTON_DPAD_RIGHT
+)
+
+// GameControllerButtonFromString returns the button with the given
+// mapping name, like "a", or ControllerButtonInvalid if name isn't
+// recognized.
+func GameControllerButtonFromString(name string) GameControllerButton {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return GameControllerButton(C.SDL_GameControllerGetButtonFromString(cname))
+}
+
+// String returns the button's mapping name, like "a".
+func (button GameControllerButton) String() string {
+	name := C.SDL_GameControllerGetStringForButton(C.SDL_GameControllerButton(button))
+	if name == nil {
+		return fmt.Sprintf("GameControllerButton(%d)", int(button))
+	}
+	return C.GoString(name)
+}
+
+// GameControllerAddMapping adds or updates a controller mapping in the
+// format used by gamecontrollerdb.txt.  It reports whether a new
+// mapping was added, as opposed to an existing one being updated.
+func GameControllerAddMapping(mapping string) (added bool, err error) {
+	cmapping := C.CString(mapping)
+	defer C.free(unsafe.Pointer(cmapping))
+	switch C.SDL_GameControllerAddMapping(cmapping) {
+	case -1:
+		return false, GetError()
+	case 1:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// GameControllerAddMappingsFromFile loads controller mappings from a
+// file like gamecontrollerdb.txt and returns the number of mappings
+// added.
+func GameControllerAddMappingsFromFile(path string) (int, error) {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+	cmode := C.CString("rb")
+	defer C.free(unsafe.Pointer(cmode))
+	rw := C.SDL_RWFromFile(cpath, cmode)
+	if rw == nil {
+		return 0, GetError()
+	}
+	n := C.SDL_GameControllerAddMappingsFromRW(rw, 1)
+	if n < 0 {
+		return 0, GetError()
+	}
+	return int(n), nil
+}
+
+// IsGameController reports whether the joystick at the given device
+// index is supported by the game controller API.
+func IsGameController(index int) bool {
+	return C.SDL_IsGameController(C.int(index)) == C.SDL_TRUE
+}
+
+// GameControllerNameForIndex returns the name of the game controller at
+// the given device index.
+func GameControllerNameForIndex(index int) string {
+	return C.GoString(C.SDL_GameControllerNameForIndex(C.int(index)))
+}
+
+// A GameController is an open game controller.
+type GameController struct {
+	c C.SDL_GameController
+}
+
+// OpenGameController opens the game controller at the given device
+// index.  The device index is not the same as the controller's instance
+// ID, which is used in controller events.
+func OpenGameController(index int) (*GameController, error) {
+	c := C.SDL_GameControllerOpen(C.int(index))
+	if c == nil {
+		return nil, GetError()
+	}
+	return (*GameController)(unsafe.Pointer(c)), nil
+}
+
+// Name returns the controller's name.
+func (gc *GameController) Name() string {
+	return C.GoString(C.SDL_GameControllerName(&gc.c))
+}
+
+// Mapping returns the controller's mapping in the format used by
+// gamecontrollerdb.txt.
+func (gc *GameController) Mapping() string {
+	mapping := C.SDL_GameControllerMapping(&gc.c)
+	if mapping == nil {
+		return ""
+	}
+	defer C.SDL_free(unsafe.Pointer(mapping))
+	return C.GoString(mapping)
+}
+
+// Joystick returns the controller's underlying joystick.  The joystick
+// is owned by the controller and must not be closed.
+func (gc *GameController) Joystick() *Joystick {
+	return (*Joystick)(unsafe.Pointer(C.SDL_GameControllerGetJoystick(&gc.c)))
+}
+
+// Attached reports whether the controller is still connected.
+func (gc *GameController) Attached() bool {
+	return C.SDL_GameControllerGetAttached(&gc.c) == C.SDL_TRUE
+}
+
+// Axis returns the current position of an axis.
+func (gc *GameController) Axis(axis GameControllerAxis) int16 {
+	return int16(C.SDL_GameControllerGetAxis(&gc.c, C.SDL_GameControllerAxis(axis)))
+}
+
+// Button reports whether a button is pressed.
+func (gc *GameController) Button(button GameControllerButton) bool {
+	return C.SDL_GameControllerGetButton(&gc.c, C.SDL_GameControllerButton(button)) != 0
+}
+
+// Close closes the controller.  It is not safe to use the controller
+// after calling Close.
+func (gc *GameController) Close() {
+	C.SDL_GameControllerClose(&gc.c)
+}
@@ -3,6 +3,129 @@ package sdl
 // #include "SDL.h"
 import "C"
 
+import (
+	"unsafe"
+)
+
+// GLAttr is an OpenGL configuration attribute.
+type GLAttr int
+
+// OpenGL attributes.  Most attributes must be set before the window is
+// created.
+const (
+	GLRedSize                GLAttr = C.SDL_GL_RED_SIZE
+	GLGreenSize              GLAttr = C.SDL_GL_GREEN_SIZE
+	GLBlueSize               GLAttr = C.SDL_GL_BLUE_SIZE
+	GLAlphaSize              GLAttr = C.SDL_GL_ALPHA_SIZE
+	GLBufferSize             GLAttr = C.SDL_GL_BUFFER_SIZE
+	GLDoubleBuffer           GLAttr = C.SDL_GL_DOUBLEBUFFER
+	GLDepthSize              GLAttr = C.SDL_GL_DEPTH_SIZE
+	GLStencilSize            GLAttr = C.SDL_GL_STENCIL_SIZE
+	GLAccumRedSize           GLAttr = C.SDL_GL_ACCUM_RED_SIZE
+	GLAccumGreenSize         GLAttr = C.SDL_GL_ACCUM_GREEN_SIZE
+	GLAccumBlueSize          GLAttr = C.SDL_GL_ACCUM_BLUE_SIZE
+	GLAccumAlphaSize         GLAttr = C.SDL_GL_ACCUM_ALPHA_SIZE
+	GLStereo                 GLAttr = C.SDL_GL_STEREO
+	GLMultisampleBuffers     GLAttr = C.SDL_GL_MULTISAMPLEBUFFERS
+	GLMultisampleSamples     GLAttr = C.SDL_GL_MULTISAMPLESAMPLES
+	GLAcceleratedVisual      GLAttr = C.SDL_GL_ACCELERATED_VISUAL
+	GLContextMajorVersion    GLAttr = C.SDL_GL_CONTEXT_MAJOR_VERSION
+	GLContextMinorVersion    GLAttr = C.SDL_GL_CONTEXT_MINOR_VERSION
+	GLContextFlags           GLAttr = C.SDL_GL_CONTEXT_FLAGS
+	GLContextProfileMask     GLAttr = C.SDL_GL_CONTEXT_PROFILE_MASK
+	GLShareWithCurrent       GLAttr = C.SDL_GL_SHARE_WITH_CURRENT_CONTEXT
+	GLFramebufferSRGBCapable GLAttr = C.SDL_GL_FRAMEBUFFER_SRGB_CAPABLE
+)
+
+// Values for GLContextProfileMask.
+const (
+	GLContextProfileCore          = C.SDL_GL_CONTEXT_PROFILE_CORE
+	GLContextProfileCompatibility = C.SDL_GL_CONTEXT_PROFILE_COMPATIBILITY
+	GLContextProfileES            = C.SDL_GL_CONTEXT_PROFILE_ES
+)
+
+// Values for GLContextFlags.  Multiple flags may be ORed together.
+const (
+	GLContextDebugFlag             = C.SDL_GL_CONTEXT_DEBUG_FLAG
+	GLContextForwardCompatibleFlag = C.SDL_GL_CONTEXT_FORWARD_COMPATIBLE_FLAG
+	GLContextRobustAccessFlag      = C.SDL_GL_CONTEXT_ROBUST_ACCESS_FLAG
+	GLContextResetIsolationFlag    = C.SDL_GL_CONTEXT_RESET_ISOLATION_FLAG
+)
+
+// GLSetAttribute sets an OpenGL attribute for windows created afterward.
+func GLSetAttribute(attr GLAttr, value int) error {
+	if C.SDL_GL_SetAttribute(C.SDL_GLattr(attr), C.int(value)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// GLGetAttribute returns the actual value of an attribute in the
+// current context.
+func GLGetAttribute(attr GLAttr) (int, error) {
+	var value C.int
+	if C.SDL_GL_GetAttribute(C.SDL_GLattr(attr), &value) != 0 {
+		return 0, GetError()
+	}
+	return int(value), nil
+}
+
+// GLResetAttributes resets all OpenGL attributes to their default values.
+func GLResetAttributes() {
+	C.SDL_GL_ResetAttributes()
+}
+
+// GLLoadLibrary dynamically loads an OpenGL library.  An empty path
+// loads the default library.  This must be called before creating an
+// OpenGL window, if at all.
+func GLLoadLibrary(path string) error {
+	var cpath *C.char
+	if path != "" {
+		cpath = C.CString(path)
+		defer C.free(unsafe.Pointer(cpath))
+	}
+	if C.SDL_GL_LoadLibrary(cpath) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// GLUnloadLibrary unloads the library loaded by GLLoadLibrary.
+func GLUnloadLibrary() {
+	C.SDL_GL_UnloadLibrary()
+}
+
+// GLGetProcAddress returns the address of an OpenGL function, or nil if
+// it is not found.
+func GLGetProcAddress(name string) unsafe.Pointer {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return C.SDL_GL_GetProcAddress(cname)
+}
+
+// GLExtensionSupported reports whether an OpenGL extension is
+// supported by the current context.
+func GLExtensionSupported(extension string) bool {
+	cext := C.CString(extension)
+	defer C.free(unsafe.Pointer(cext))
+	return C.SDL_GL_ExtensionSupported(cext) == C.SDL_TRUE
+}
+
+// GLSetSwapInterval sets the swap interval for the current context: 0
+// for immediate updates, 1 for updates synchronized with the vertical
+// retrace, or -1 for adaptive vsync.
+func GLSetSwapInterval(interval int) error {
+	if C.SDL_GL_SetSwapInterval(C.int(interval)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// GLGetSwapInterval returns the swap interval for the current context.
+func GLGetSwapInterval() int {
+	return int(C.SDL_GL_GetSwapInterval())
+}
+
 // A GLContext is an opaque handle to an OpenGL context.
 type GLContext struct {
 	c C.SDL_GLContext
@@ -17,6 +140,18 @@ func NewGLContext(w *Window) (GLContext, error) {
 	return GLContext{context}, nil
 }
 
+// GLCurrentContext returns the current OpenGL context, or the zero
+// GLContext if there is no current context.
+func GLCurrentContext() GLContext {
+	return GLContext{C.SDL_GL_GetCurrentContext()}
+}
+
+// GLCurrentWindow returns the window associated with the current
+// OpenGL context, or nil if there is none.
+func GLCurrentWindow() *Window {
+	return (*Window)(unsafe.Pointer(C.SDL_GL_GetCurrentWindow()))
+}
+
 // MakeCurrent makes the context the current context and associates it with w.
 // w must be a compatible window.
 func (ctx GLContext) MakeCurrent(w *Window) error {
@@ -37,3 +172,11 @@ func (ctx GLContext) Destroy() {
 func (w *Window) GLSwap() {
 	C.SDL_GL_SwapWindow(&w.w)
 }
+
+// GLGetDrawableSize returns the size of a window's OpenGL drawable in
+// pixels.  On high-DPI displays this may be larger than the window's Size.
+func (w *Window) GLGetDrawableSize() (int, int) {
+	var width, height C.int
+	C.SDL_GL_GetDrawableSize(&w.w, &width, &height)
+	return int(width), int(height)
+}
@@ -0,0 +1,573 @@
+package sdl
+
+// #include "SDL.h"
+//
+// // Simple rumble was added in SDL 2.0.9.
+// static int joystickRumble(SDL_Joystick *j, Uint16 low, Uint16 high, Uint32 ms) {
+// #if SDL_VERSION_ATLEAST(2,0,9)
+// 	return SDL_JoystickRumble(j, low, high, ms);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+//
+// static int gameControllerRumble(SDL_GameController *gc, Uint16 low, Uint16 high, Uint32 ms) {
+// #if SDL_VERSION_ATLEAST(2,0,9)
+// 	return SDL_GameControllerRumble(gc, low, high, ms);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+import "C"
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"unsafe"
+)
+
+// Rumble starts a rumble effect on the joystick, replacing any previous
+// rumble.  low and high are the intensities of the low and high
+// frequency motors.  Zero intensities stop the rumble.  It requires SDL
+// 2.0.9 or later and returns an error otherwise.
+func (j *Joystick) Rumble(low, high uint16, duration time.Duration) error {
+	if C.joystickRumble(&j.j, C.Uint16(low), C.Uint16(high), C.Uint32(duration/time.Millisecond)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// Rumble starts a rumble effect on the controller, replacing any
+// previous rumble.  low and high are the intensities of the low and high
+// frequency motors.  Zero intensities stop the rumble.  It requires SDL
+// 2.0.9 or later and returns an error otherwise.
+func (gc *GameController) Rumble(low, high uint16, duration time.Duration) error {
+	if C.gameControllerRumble(&gc.c, C.Uint16(low), C.Uint16(high), C.Uint32(duration/time.Millisecond)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// HapticFeature is a set of haptic device capabilities.  The effect
+// features also name the effect types.
+type HapticFeature uint32
+
+// Haptic features.
+const (
+	HapticConstant     HapticFeature = C.SDL_HAPTIC_CONSTANT
+	HapticSine         HapticFeature = C.SDL_HAPTIC_SINE
+	HapticLeftRight    HapticFeature = C.SDL_HAPTIC_LEFTRIGHT
+	HapticTriangle     HapticFeature = C.SDL_HAPTIC_TRIANGLE
+	HapticSawtoothUp   HapticFeature = C.SDL_HAPTIC_SAWTOOTHUP
+	HapticSawtoothDown HapticFeature = C.SDL_HAPTIC_SAWTOOTHDOWN
+	HapticRamp         HapticFeature = C.SDL_HAPTIC_RAMP
+	HapticSpring       HapticFeature = C.SDL_HAPTIC_SPRING
+	HapticDamper       HapticFeature = C.SDL_HAPTIC_DAMPER
+	HapticInertia      HapticFeature = C.SDL_HAPTIC_INERTIA
+	HapticFriction     HapticFeature = C.SDL_HAPTIC_FRICTION
+	HapticCustom       HapticFeature = C.SDL_HAPTIC_CUSTOM
+
+	// Device features that are not effects.
+	HapticGain       HapticFeature = C.SDL_HAPTIC_GAIN
+	HapticAutocenter HapticFeature = C.SDL_HAPTIC_AUTOCENTER
+	HapticStatus     HapticFeature = C.SDL_HAPTIC_STATUS
+	HapticPause      HapticFeature = C.SDL_HAPTIC_PAUSE
+)
+
+// HapticInfinity may be used as an effect's Length to play it until it
+// is stopped.
+const HapticInfinity time.Duration = -1
+
+// HapticInfiniteIterations may be passed to RunEffect to repeat an
+// effect until it is stopped.
+const HapticInfiniteIterations = uint32(C.SDL_HAPTIC_INFINITY)
+
+// HapticDirectionType is a coordinate system for a HapticDirection.
+type HapticDirectionType uint8
+
+// Haptic direction coordinate systems.
+const (
+	HapticPolar     HapticDirectionType = C.SDL_HAPTIC_POLAR
+	HapticCartesian HapticDirectionType = C.SDL_HAPTIC_CARTESIAN
+	HapticSpherical HapticDirectionType = C.SDL_HAPTIC_SPHERICAL
+)
+
+// HapticDirection is the direction an effect comes from.  See
+// SDL_haptic.h for how Dir is interpreted in each coordinate system.
+type HapticDirection struct {
+	Type HapticDirectionType
+	Dir  [3]int32
+}
+
+func (d HapticDirection) toC() C.SDL_HapticDirection {
+	var cd C.SDL_HapticDirection
+	cd._type = C.Uint8(d.Type)
+	for i := range d.Dir {
+		cd.dir[i] = C.Sint32(d.Dir[i])
+	}
+	return cd
+}
+
+// HapticReplay holds the timing of an effect.
+type HapticReplay struct {
+	Length time.Duration // or HapticInfinity
+	Delay  time.Duration
+}
+
+// HapticTrigger holds the button that triggers an effect.
+type HapticTrigger struct {
+	Button   uint16
+	Interval time.Duration // minimum time between triggers
+}
+
+// HapticEnvelope shapes the start and end of an effect.
+type HapticEnvelope struct {
+	AttackLength time.Duration
+	AttackLevel  uint16
+	FadeLength   time.Duration
+	FadeLevel    uint16
+}
+
+// HapticEffect is a haptic effect description.  It is implemented by
+// HapticConstantEffect, HapticPeriodicEffect, HapticConditionEffect,
+// HapticRampEffect, HapticLeftRightEffect and HapticCustomEffect.
+type HapticEffect interface {
+	// toC fills in ce and returns any C memory that must live as long
+	// as the effect, or nil.  It returns an error if the effect cannot
+	// be represented in an SDL_HapticEffect.
+	toC(ce *C.SDL_HapticEffect) (unsafe.Pointer, error)
+}
+
+// HapticConstantEffect applies a constant force.
+type HapticConstantEffect struct {
+	Direction HapticDirection
+	HapticReplay
+	HapticTrigger
+	Level int16
+	HapticEnvelope
+}
+
+func (e *HapticConstantEffect) toC(ce *C.SDL_HapticEffect) (unsafe.Pointer, error) {
+	if err := checkHapticMS(e.Delay, e.Interval, e.AttackLength, e.FadeLength); err != nil {
+		return nil, err
+	}
+	c := (*C.SDL_HapticConstant)(unsafe.Pointer(ce))
+	c._type = C.SDL_HAPTIC_CONSTANT
+	c.direction = e.Direction.toC()
+	c.length = hapticLength(e.Length)
+	c.delay = hapticMS(e.Delay)
+	c.button = C.Uint16(e.Button)
+	c.interval = hapticMS(e.Interval)
+	c.level = C.Sint16(e.Level)
+	c.attack_length = hapticMS(e.AttackLength)
+	c.attack_level = C.Uint16(e.AttackLevel)
+	c.fade_length = hapticMS(e.FadeLength)
+	c.fade_level = C.Uint16(e.FadeLevel)
+	return nil, nil
+}
+
+// HapticPeriodicEffect applies a force that follows a waveform.
+type HapticPeriodicEffect struct {
+	// Waveform is one of HapticSine, HapticTriangle, HapticSawtoothUp
+	// or HapticSawtoothDown.
+	Waveform  HapticFeature
+	Direction HapticDirection
+	HapticReplay
+	HapticTrigger
+	Period    time.Duration
+	Magnitude int16  // peak value; negative values invert the wave
+	Offset    int16  // mean value of the wave
+	Phase     uint16 // horizontal shift in hundredths of a degree
+	HapticEnvelope
+}
+
+func (e *HapticPeriodicEffect) toC(ce *C.SDL_HapticEffect) (unsafe.Pointer, error) {
+	if err := checkHapticMS(e.Delay, e.Interval, e.Period, e.AttackLength, e.FadeLength); err != nil {
+		return nil, err
+	}
+	c := (*C.SDL_HapticPeriodic)(unsafe.Pointer(ce))
+	c._type = C.Uint16(e.Waveform)
+	c.direction = e.Direction.toC()
+	c.length = hapticLength(e.Length)
+	c.delay = hapticMS(e.Delay)
+	c.button = C.Uint16(e.Button)
+	c.interval = hapticMS(e.Interval)
+	c.period = hapticMS(e.Period)
+	c.magnitude = C.Sint16(e.Magnitude)
+	c.offset = C.Sint16(e.Offset)
+	c.phase = C.Uint16(e.Phase)
+	c.attack_length = hapticMS(e.AttackLength)
+	c.attack_level = C.Uint16(e.AttackLevel)
+	c.fade_length = hapticMS(e.FadeLength)
+	c.fade_level = C.Uint16(e.FadeLevel)
+	return nil, nil
+}
+
+// HapticConditionEffect applies a force based on the position or
+// motion of the device's axes.  The arrays hold one value per axis.
+type HapticConditionEffect struct {
+	// Condition is one of HapticSpring, HapticDamper, HapticInertia
+	// or HapticFriction.
+	Condition HapticFeature
+	Direction HapticDirection
+	HapticReplay
+	HapticTrigger
+	RightSat   [3]uint16
+	LeftSat    [3]uint16
+	RightCoeff [3]int16
+	LeftCoeff  [3]int16
+	Deadband   [3]uint16
+	Center     [3]int16
+}
+
+func (e *HapticConditionEffect) toC(ce *C.SDL_HapticEffect) (unsafe.Pointer, error) {
+	if err := checkHapticMS(e.Delay, e.Interval); err != nil {
+		return nil, err
+	}
+	c := (*C.SDL_HapticCondition)(unsafe.Pointer(ce))
+	c._type = C.Uint16(e.Condition)
+	c.direction = e.Direction.toC()
+	c.length = hapticLength(e.Length)
+	c.delay = hapticMS(e.Delay)
+	c.button = C.Uint16(e.Button)
+	c.interval = hapticMS(e.Interval)
+	for i := 0; i < 3; i++ {
+		c.right_sat[i] = C.Uint16(e.RightSat[i])
+		c.left_sat[i] = C.Uint16(e.LeftSat[i])
+		c.right_coeff[i] = C.Sint16(e.RightCoeff[i])
+		c.left_coeff[i] = C.Sint16(e.LeftCoeff[i])
+		c.deadband[i] = C.Uint16(e.Deadband[i])
+		c.center[i] = C.Sint16(e.Center[i])
+	}
+	return nil, nil
+}
+
+// HapticRampEffect applies a force that changes linearly from Start to End.
+type HapticRampEffect struct {
+	Direction HapticDirection
+	HapticReplay
+	HapticTrigger
+	Start, End int16
+	HapticEnvelope
+}
+
+func (e *HapticRampEffect) toC(ce *C.SDL_HapticEffect) (unsafe.Pointer, error) {
+	if err := checkHapticMS(e.Delay, e.Interval, e.AttackLength, e.FadeLength); err != nil {
+		return nil, err
+	}
+	c := (*C.SDL_HapticRamp)(unsafe.Pointer(ce))
+	c._type = C.SDL_HAPTIC_RAMP
+	c.direction = e.Direction.toC()
+	c.length = hapticLength(e.Length)
+	c.delay = hapticMS(e.Delay)
+	c.button = C.Uint16(e.Button)
+	c.interval = hapticMS(e.Interval)
+	c.start = C.Sint16(e.Start)
+	c.end = C.Sint16(e.End)
+	c.attack_length = hapticMS(e.AttackLength)
+	c.attack_level = C.Uint16(e.AttackLevel)
+	c.fade_length = hapticMS(e.FadeLength)
+	c.fade_level = C.Uint16(e.FadeLevel)
+	return nil, nil
+}
+
+// HapticLeftRightEffect drives the large and small motors of a rumble
+// device directly.
+type HapticLeftRightEffect struct {
+	Length         time.Duration // or HapticInfinity
+	LargeMagnitude uint16
+	SmallMagnitude uint16
+}
+
+func (e *HapticLeftRightEffect) toC(ce *C.SDL_HapticEffect) (unsafe.Pointer, error) {
+	c := (*C.SDL_HapticLeftRight)(unsafe.Pointer(ce))
+	c._type = C.SDL_HAPTIC_LEFTRIGHT
+	c.length = hapticLength(e.Length)
+	c.large_magnitude = C.Uint16(e.LargeMagnitude)
+	c.small_magnitude = C.Uint16(e.SmallMagnitude)
+	return nil, nil
+}
+
+// HapticCustomEffect plays back arbitrary force samples.  Data holds
+// interleaved samples, one per channel per Period, so its length must
+// be a multiple of Channels.
+type HapticCustomEffect struct {
+	Direction HapticDirection
+	HapticReplay
+	HapticTrigger
+	Channels uint8
+	Period   time.Duration // time between samples
+	Data     []uint16
+	HapticEnvelope
+}
+
+func (e *HapticCustomEffect) toC(ce *C.SDL_HapticEffect) (unsafe.Pointer, error) {
+	if err := checkHapticMS(e.Delay, e.Interval, e.Period, e.AttackLength, e.FadeLength); err != nil {
+		return nil, err
+	}
+	c := (*C.SDL_HapticCustom)(unsafe.Pointer(ce))
+	c._type = C.SDL_HAPTIC_CUSTOM
+	c.direction = e.Direction.toC()
+	c.length = hapticLength(e.Length)
+	c.delay = hapticMS(e.Delay)
+	c.button = C.Uint16(e.Button)
+	c.interval = hapticMS(e.Interval)
+	c.channels = C.Uint8(e.Channels)
+	c.period = hapticMS(e.Period)
+	c.attack_length = hapticMS(e.AttackLength)
+	c.attack_level = C.Uint16(e.AttackLevel)
+	c.fade_length = hapticMS(e.FadeLength)
+	c.fade_level = C.Uint16(e.FadeLevel)
+	if e.Channels == 0 || len(e.Data) == 0 {
+		return nil, nil
+	}
+	if len(e.Data)%int(e.Channels) != 0 {
+		return nil, Error(fmt.Sprintf("haptic custom effect has %d samples, not a multiple of %d channels", len(e.Data), e.Channels))
+	}
+	samples := len(e.Data) / int(e.Channels)
+	if samples > math.MaxUint16 {
+		return nil, Error(fmt.Sprintf("haptic custom effect has %d samples per channel, more than %d", samples, math.MaxUint16))
+	}
+	c.samples = C.Uint16(samples)
+	// SDL keeps the data pointer for the life of the effect, so it
+	// must be in C memory.
+	data := C.SDL_malloc(C.size_t(len(e.Data)) * C.size_t(unsafe.Sizeof(C.Uint16(0))))
+	if data == nil {
+		return nil, Error("haptic custom effect: out of memory")
+	}
+	copy(unsafe.Slice((*uint16)(data), len(e.Data)), e.Data)
+	c.data = (*C.Uint16)(data)
+	return data, nil
+}
+
+// checkHapticMS returns an error if any of ds does not fit in the
+// 16-bit millisecond fields of an SDL_HapticEffect.
+func checkHapticMS(ds ...time.Duration) error {
+	for _, d := range ds {
+		if d < 0 || d/time.Millisecond > math.MaxUint16 {
+			return Error(fmt.Sprintf("haptic duration %v out of range [0, %v]", d, math.MaxUint16*time.Millisecond))
+		}
+	}
+	return nil
+}
+
+// hapticMS converts d to 16-bit milliseconds.  d must have been
+// checked with checkHapticMS.
+func hapticMS(d time.Duration) C.Uint16 {
+	return C.Uint16(d / time.Millisecond)
+}
+
+// hapticLength converts an effect length to milliseconds.
+func hapticLength(d time.Duration) C.Uint32 {
+	if d < 0 {
+		return C.SDL_HAPTIC_INFINITY
+	}
+	return C.Uint32(d / time.Millisecond)
+}
+
+// NumHaptics returns the number of haptic devices attached.
+// The haptic subsystem must be initialized.
+func NumHaptics() (int, error) {
+	n := C.SDL_NumHaptics()
+	if n < 0 {
+		return 0, GetError()
+	}
+	return int(n), nil
+}
+
+// HapticName returns the name of the haptic device at the given index,
+// which is in the range [0, NumHaptics()).
+func HapticName(index int) string {
+	return C.GoString(C.SDL_HapticName(C.int(index)))
+}
+
+// HapticEffectID identifies an effect uploaded to a haptic device.
+type HapticEffectID int
+
+// A Haptic is an open haptic (force feedback) device.
+type Haptic struct {
+	h *C.SDL_Haptic
+
+	// customData holds the C sample buffers of custom effects.
+	customData map[HapticEffectID]unsafe.Pointer
+}
+
+func newHaptic(h *C.SDL_Haptic) *Haptic {
+	return &Haptic{h: h, customData: make(map[HapticEffectID]unsafe.Pointer)}
+}
+
+// OpenHaptic opens the haptic device at the given index.
+func OpenHaptic(index int) (*Haptic, error) {
+	h := C.SDL_HapticOpen(C.int(index))
+	if h == nil {
+		return nil, GetError()
+	}
+	return newHaptic(h), nil
+}
+
+// HapticFromJoystick opens the haptic device of a joystick.  The
+// haptic device must be closed before the joystick.
+func HapticFromJoystick(j *Joystick) (*Haptic, error) {
+	h := C.SDL_HapticOpenFromJoystick(&j.j)
+	if h == nil {
+		return nil, GetError()
+	}
+	return newHaptic(h), nil
+}
+
+// Name returns the device's name.
+func (h *Haptic) Name() string {
+	return HapticName(int(C.SDL_HapticIndex(h.h)))
+}
+
+// Query returns the device's supported features.
+func (h *Haptic) Query() HapticFeature {
+	return HapticFeature(C.SDL_HapticQuery(h.h))
+}
+
+// NumAxes returns the number of axes the device has.
+func (h *Haptic) NumAxes() int {
+	return int(C.SDL_HapticNumAxes(h.h))
+}
+
+// NumEffects returns the number of effects the device can store.
+func (h *Haptic) NumEffects() int {
+	return int(C.SDL_HapticNumEffects(h.h))
+}
+
+// SetGain sets the global gain of the device, from 0 to 100.  The
+// device must support HapticGain.
+func (h *Haptic) SetGain(gain int) error {
+	if C.SDL_HapticSetGain(h.h, C.int(gain)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// SetAutocenter sets the device's autocenter strength, from 0 to 100.
+// Zero disables autocentering.  The device must support HapticAutocenter.
+func (h *Haptic) SetAutocenter(autocenter int) error {
+	if C.SDL_HapticSetAutocenter(h.h, C.int(autocenter)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// RumbleSupported reports whether the device supports simple rumble.
+func (h *Haptic) RumbleSupported() bool {
+	return C.SDL_HapticRumbleSupported(h.h) == C.SDL_TRUE
+}
+
+// RumbleInit prepares the device for RumblePlay.
+func (h *Haptic) RumbleInit() error {
+	if C.SDL_HapticRumbleInit(h.h) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// RumblePlay plays a rumble effect with a strength from 0 to 1.
+func (h *Haptic) RumblePlay(strength float32, duration time.Duration) error {
+	if C.SDL_HapticRumblePlay(h.h, C.float(strength), hapticLength(duration)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// RumbleStop stops the rumble effect.
+func (h *Haptic) RumbleStop() error {
+	if C.SDL_HapticRumbleStop(h.h) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// EffectSupported reports whether the device supports an effect.
+func (h *Haptic) EffectSupported(e HapticEffect) bool {
+	var ce C.SDL_HapticEffect
+	data, err := e.toC(&ce)
+	if err != nil {
+		return false
+	}
+	defer C.SDL_free(data)
+	return C.SDL_HapticEffectSupported(h.h, &ce) == C.SDL_TRUE
+}
+
+// NewEffect uploads an effect to the device.  Durations other than the
+// effect's length must be between zero and 65.535 seconds.
+func (h *Haptic) NewEffect(e HapticEffect) (HapticEffectID, error) {
+	var ce C.SDL_HapticEffect
+	data, err := e.toC(&ce)
+	if err != nil {
+		return 0, err
+	}
+	id := C.SDL_HapticNewEffect(h.h, &ce)
+	if id < 0 {
+		C.SDL_free(data)
+		return 0, GetError()
+	}
+	if data != nil {
+		h.customData[HapticEffectID(id)] = data
+	}
+	return HapticEffectID(id), nil
+}
+
+// UpdateEffect changes the parameters of an uploaded effect.  The
+// effect's type may not be changed.
+func (h *Haptic) UpdateEffect(id HapticEffectID, e HapticEffect) error {
+	var ce C.SDL_HapticEffect
+	data, err := e.toC(&ce)
+	if err != nil {
+		return err
+	}
+	if C.SDL_HapticUpdateEffect(h.h, C.int(id), &ce) != 0 {
+		C.SDL_free(data)
+		return GetError()
+	}
+	h.freeCustomData(id)
+	if data != nil {
+		h.customData[id] = data
+	}
+	return nil
+}
+
+// RunEffect plays an uploaded effect iterations times, or until it is
+// stopped if iterations is HapticInfiniteIterations.
+func (h *Haptic) RunEffect(id HapticEffectID, iterations uint32) error {
+	if C.SDL_HapticRunEffect(h.h, C.int(id), C.Uint32(iterations)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// StopEffect stops a running effect.
+func (h *Haptic) StopEffect(id HapticEffectID) error {
+	if C.SDL_HapticStopEffect(h.h, C.int(id)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// DestroyEffect stops and removes an effect from the device.  The ID
+// should not be used after calling DestroyEffect.
+func (h *Haptic) DestroyEffect(id HapticEffectID) {
+	C.SDL_HapticDestroyEffect(h.h, C.int(id))
+	h.freeCustomData(id)
+}
+
+func (h *Haptic) freeCustomData(id HapticEffectID) {
+	if data, ok := h.customData[id]; ok {
+		C.SDL_free(data)
+		delete(h.customData, id)
+	}
+}
+
+// Close closes the device, destroying all of its effects.  It is not
+// safe to use the device after calling Close.
+func (h *Haptic) Close() {
+	C.SDL_HapticClose(h.h)
+	for id := range h.customData {
+		h.freeCustomData(id)
+	}
+}
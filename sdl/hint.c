@@ -0,0 +1,14 @@
+#include "SDL.h"
+#include "_cgo_export.h"
+
+static void hintCallback(void *userdata, const char *name, const char *oldValue, const char *newValue) {
+	goHintCallback((uintptr_t)userdata, (char *)name, (char *)oldValue, (char *)newValue);
+}
+
+void addHintCallback(const char *name, uintptr_t id) {
+	SDL_AddHintCallback(name, hintCallback, (void *)id);
+}
+
+void delHintCallback(const char *name, uintptr_t id) {
+	SDL_DelHintCallback(name, hintCallback, (void *)id);
+}
@@ -0,0 +1,141 @@
+package sdl
+
+// #include <stdint.h>
+// #include "SDL.h"
+//
+// void addHintCallback(const char *name, uintptr_t id);
+// void delHintCallback(const char *name, uintptr_t id);
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// HintPriority determines whether a hint may override an existing value.
+type HintPriority int
+
+// Hint priorities.
+const (
+	// Low priority, used for default values
+	HintDefault HintPriority = C.SDL_HINT_DEFAULT
+	// Medium priority
+	HintNormal HintPriority = C.SDL_HINT_NORMAL
+	// High priority, overrides environment variables
+	HintOverride HintPriority = C.SDL_HINT_OVERRIDE
+)
+
+// Common hint names.  See SDL_hints.h for the values each hint accepts.
+// Hints added in later versions of SDL are ignored by older versions.
+const (
+	HintFramebufferAcceleration       = "SDL_FRAMEBUFFER_ACCELERATION"
+	HintRenderDriver                  = "SDL_RENDER_DRIVER"
+	HintRenderOpenGLShaders           = "SDL_RENDER_OPENGL_SHADERS"
+	HintRenderScaleQuality            = "SDL_RENDER_SCALE_QUALITY"
+	HintRenderVSync                   = "SDL_RENDER_VSYNC"
+	HintVideoAllowScreensaver         = "SDL_VIDEO_ALLOW_SCREENSAVER"
+	HintVideoHighDPIDisabled          = "SDL_VIDEO_HIGHDPI_DISABLED"
+	HintVideoMinimizeOnFocusLoss      = "SDL_VIDEO_MINIMIZE_ON_FOCUS_LOSS"
+	HintVideoX11NetWMBypassCompositor = "SDL_VIDEO_X11_NET_WM_BYPASS_COMPOSITOR"
+	HintVideoX11XRandR                = "SDL_VIDEO_X11_XRANDR"
+	HintGrabKeyboard                  = "SDL_GRAB_KEYBOARD"
+	HintMouseRelativeModeWarp         = "SDL_MOUSE_RELATIVE_MODE_WARP"
+	HintJoystickAllowBackgroundEvents = "SDL_JOYSTICK_ALLOW_BACKGROUND_EVENTS"
+	HintGameControllerConfig          = "SDL_GAMECONTROLLERCONFIG"
+	HintAudioResamplingMode           = "SDL_AUDIO_RESAMPLING_MODE"
+	HintTimerResolution               = "SDL_TIMER_RESOLUTION"
+	HintNoSignalHandlers              = "SDL_NO_SIGNAL_HANDLERS"
+)
+
+// SetHint sets a hint with normal priority.  It reports whether the
+// hint was set, which fails if the hint was set with override priority
+// or by an environment variable.
+//
+// Hints do not require SDL to be initialized.  The package's
+// initialization does not start any subsystems, so hints that affect a
+// subsystem take effect as long as they are set before the matching
+// call to Init.
+func SetHint(name, value string) bool {
+	return SetHintWithPriority(name, value, HintNormal)
+}
+
+// SetHintWithPriority sets a hint with a specific priority.  It reports
+// whether the hint was set.
+func SetHintWithPriority(name, value string, priority HintPriority) bool {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	cvalue := C.CString(value)
+	defer C.free(unsafe.Pointer(cvalue))
+	return C.SDL_SetHintWithPriority(cname, cvalue, C.SDL_HintPriority(priority)) == C.SDL_TRUE
+}
+
+// GetHint returns the value of a hint and whether it has been set.
+func GetHint(name string) (value string, ok bool) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	cvalue := C.SDL_GetHint(cname)
+	if cvalue == nil {
+		return "", false
+	}
+	return C.GoString(cvalue), true
+}
+
+// ClearHints resets all hints to their default values and removes all
+// hint callbacks.
+func ClearHints() {
+	C.SDL_ClearHints()
+	hintCallbacks.Lock()
+	hintCallbacks.m = make(map[uintptr]func(oldValue, newValue string))
+	hintCallbacks.Unlock()
+}
+
+var hintCallbacks = struct {
+	sync.Mutex
+	m    map[uintptr]func(oldValue, newValue string)
+	next uintptr
+}{
+	m: make(map[uintptr]func(oldValue, newValue string)),
+}
+
+// AddHintCallback calls f whenever the named hint changes and returns
+// a function that removes the callback.  f is called immediately with
+// the hint's current value.  f runs on the goroutine that changed the
+// hint, so it must not call Do if the hint is changed from Do.
+func AddHintCallback(name string, f func(oldValue, newValue string)) (remove func()) {
+	hintCallbacks.Lock()
+	hintCallbacks.next++
+	id := hintCallbacks.next
+	hintCallbacks.m[id] = f
+	hintCallbacks.Unlock()
+
+	cname := C.CString(name)
+	C.addHintCallback(cname, C.uintptr_t(id))
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			C.delHintCallback(cname, C.uintptr_t(id))
+			C.free(unsafe.Pointer(cname))
+			hintCallbacks.Lock()
+			delete(hintCallbacks.m, id)
+			hintCallbacks.Unlock()
+		})
+	}
+}
+
+//export goHintCallback
+func goHintCallback(id C.uintptr_t, name, oldValue, newValue *C.char) {
+	hintCallbacks.Lock()
+	f := hintCallbacks.m[uintptr(id)]
+	hintCallbacks.Unlock()
+	if f == nil {
+		return
+	}
+	f(goStringOrEmpty(oldValue), goStringOrEmpty(newValue))
+}
+
+func goStringOrEmpty(s *C.char) string {
+	if s == nil {
+		return ""
+	}
+	return C.GoString(s)
+}
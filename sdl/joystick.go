@@ -1,8 +1,40 @@
 package sdl
 
 // #include "SDL.h"
+//
+// // Power levels and instance ID lookup were added in SDL 2.0.4.
+// #if !SDL_VERSION_ATLEAST(2,0,4)
+// #define SDL_JOYSTICK_POWER_UNKNOWN -1
+// #define SDL_JOYSTICK_POWER_EMPTY 0
+// #define SDL_JOYSTICK_POWER_LOW 1
+// #define SDL_JOYSTICK_POWER_MEDIUM 2
+// #define SDL_JOYSTICK_POWER_FULL 3
+// #define SDL_JOYSTICK_POWER_WIRED 4
+// #define SDL_JOYSTICK_POWER_MAX 5
+// #endif
+//
+// static int joystickCurrentPowerLevel(SDL_Joystick *j) {
+// #if SDL_VERSION_ATLEAST(2,0,4)
+// 	return SDL_JoystickCurrentPowerLevel(j);
+// #else
+// 	return SDL_JOYSTICK_POWER_UNKNOWN;
+// #endif
+// }
+//
+// static SDL_Joystick *joystickFromInstanceID(SDL_JoystickID id) {
+// #if SDL_VERSION_ATLEAST(2,0,4)
+// 	return SDL_JoystickFromInstanceID(id);
+// #else
+// 	return NULL;
+// #endif
+// }
 import "C"
 
+import (
+	"fmt"
+	"unsafe"
+)
+
 // JoystickID is a transient joystick ID.
 type JoystickID int32
 
@@ -25,3 +57,173 @@ const (
 	HatLeftUp    HatPosition = C.SDL_HAT_LEFTUP
 	HatLeftDown  HatPosition = C.SDL_HAT_LEFTDOWN
 )
+
+// JoystickGUID is a stable identifier for a type of joystick.
+type JoystickGUID [16]byte
+
+// String returns the GUID in SDL's hexadecimal form.
+func (guid JoystickGUID) String() string {
+	var cguid C.SDL_JoystickGUID
+	for i := range guid {
+		cguid.data[i] = C.Uint8(guid[i])
+	}
+	var buf [33]C.char
+	C.SDL_JoystickGetGUIDString(cguid, &buf[0], C.int(len(buf)))
+	return C.GoString(&buf[0])
+}
+
+func newJoystickGUID(cguid C.SDL_JoystickGUID) JoystickGUID {
+	var guid JoystickGUID
+	for i := range guid {
+		guid[i] = byte(cguid.data[i])
+	}
+	return guid
+}
+
+// JoystickPowerLevel is the battery level of a joystick.
+type JoystickPowerLevel int
+
+// Joystick power levels.
+const (
+	JoystickPowerUnknown JoystickPowerLevel = C.SDL_JOYSTICK_POWER_UNKNOWN
+	JoystickPowerEmpty   JoystickPowerLevel = C.SDL_JOYSTICK_POWER_EMPTY
+	JoystickPowerLow     JoystickPowerLevel = C.SDL_JOYSTICK_POWER_LOW
+	JoystickPowerMedium  JoystickPowerLevel = C.SDL_JOYSTICK_POWER_MEDIUM
+	JoystickPowerFull    JoystickPowerLevel = C.SDL_JOYSTICK_POWER_FULL
+	JoystickPowerWired   JoystickPowerLevel = C.SDL_JOYSTICK_POWER_WIRED
+)
+
+// String returns the power level's name like "JoystickPowerFull".
+func (level JoystickPowerLevel) String() string {
+	switch level {
+	case JoystickPowerUnknown:
+		return "JoystickPowerUnknown"
+	case JoystickPowerEmpty:
+		return "JoystickPowerEmpty"
+	case JoystickPowerLow:
+		return "JoystickPowerLow"
+	case JoystickPowerMedium:
+		return "JoystickPowerMedium"
+	case JoystickPowerFull:
+		return "JoystickPowerFull"
+	case JoystickPowerWired:
+		return "JoystickPowerWired"
+	default:
+		return fmt.Sprintf("JoystickPowerLevel(%d)", int(level))
+	}
+}
+
+// NumJoysticks returns the number of attached joysticks.
+// The joystick subsystem must be initialized.
+func NumJoysticks() (int, error) {
+	n := C.SDL_NumJoysticks()
+	if n < 0 {
+		return 0, GetError()
+	}
+	return int(n), nil
+}
+
+// JoystickNameForIndex returns the name of the joystick at the given
+// device index, which is in the range [0, NumJoysticks()).
+func JoystickNameForIndex(index int) string {
+	return C.GoString(C.SDL_JoystickNameForIndex(C.int(index)))
+}
+
+// A Joystick is an open joystick device.
+type Joystick struct {
+	j C.SDL_Joystick
+}
+
+// OpenJoystick opens the joystick at the given device index.  The
+// device index is not the same as the joystick's instance ID, which is
+// used in joystick events.
+func OpenJoystick(index int) (*Joystick, error) {
+	j := C.SDL_JoystickOpen(C.int(index))
+	if j == nil {
+		return nil, GetError()
+	}
+	return (*Joystick)(unsafe.Pointer(j)), nil
+}
+
+// JoystickFromInstanceID returns the open joystick with the given
+// instance ID, or nil if there is none.  It always returns nil before
+// SDL 2.0.4.
+func JoystickFromInstanceID(id JoystickID) *Joystick {
+	return (*Joystick)(unsafe.Pointer(C.joystickFromInstanceID(C.SDL_JoystickID(id))))
+}
+
+// Name returns the joystick's name.
+func (j *Joystick) Name() string {
+	return C.GoString(C.SDL_JoystickName(&j.j))
+}
+
+// GUID returns the joystick's GUID.
+func (j *Joystick) GUID() JoystickGUID {
+	return newJoystickGUID(C.SDL_JoystickGetGUID(&j.j))
+}
+
+// InstanceID returns the joystick's instance ID, as used in joystick events.
+func (j *Joystick) InstanceID() JoystickID {
+	return JoystickID(C.SDL_JoystickInstanceID(&j.j))
+}
+
+// Attached reports whether the joystick is still connected.
+func (j *Joystick) Attached() bool {
+	return C.SDL_JoystickGetAttached(&j.j) == C.SDL_TRUE
+}
+
+// PowerLevel returns the joystick's battery level.
+func (j *Joystick) PowerLevel() JoystickPowerLevel {
+	return JoystickPowerLevel(C.joystickCurrentPowerLevel(&j.j))
+}
+
+// NumAxes returns the number of axes on the joystick.
+func (j *Joystick) NumAxes() int {
+	return int(C.SDL_JoystickNumAxes(&j.j))
+}
+
+// Axis returns the current position of an axis, in the range
+// [-32768, 32767].
+func (j *Joystick) Axis(axis int) int16 {
+	return int16(C.SDL_JoystickGetAxis(&j.j, C.int(axis)))
+}
+
+// NumButtons returns the number of buttons on the joystick.
+func (j *Joystick) NumButtons() int {
+	return int(C.SDL_JoystickNumButtons(&j.j))
+}
+
+// Button reports whether a button is pressed.
+func (j *Joystick) Button(button int) bool {
+	return C.SDL_JoystickGetButton(&j.j, C.int(button)) != 0
+}
+
+// NumHats returns the number of hats on the joystick.
+func (j *Joystick) NumHats() int {
+	return int(C.SDL_JoystickNumHats(&j.j))
+}
+
+// Hat returns the current position of a hat.
+func (j *Joystick) Hat(hat int) HatPosition {
+	return HatPosition(C.SDL_JoystickGetHat(&j.j, C.int(hat)))
+}
+
+// NumBalls returns the number of trackballs on the joystick.
+func (j *Joystick) NumBalls() int {
+	return int(C.SDL_JoystickNumBalls(&j.j))
+}
+
+// Ball returns the motion of a trackball since the last call to Ball.
+func (j *Joystick) Ball(ball int) (dx, dy int, err error) {
+	var cdx, cdy C.int
+	if C.SDL_JoystickGetBall(&j.j, C.int(ball), &cdx, &cdy) != 0 {
+		return 0, 0, GetError()
+	}
+	return int(cdx), int(cdy), nil
+}
+
+// Close closes the joystick.  It is not safe to use the joystick after
+// calling Close.
+func (j *Joystick) Close() {
+	C.SDL_JoystickClose(&j.j)
+}
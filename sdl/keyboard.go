@@ -0,0 +1,73 @@
+package sdl
+
+// #include "SDL.h"
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/adam000/Go-SDL2/sdl/keys"
+)
+
+// KeyboardSnapshot holds the state of every key at one point in time,
+// indexed by scancode.  A true value means the key is pressed.
+type KeyboardSnapshot []bool
+
+// IsPressed reports whether the key with the given scancode was
+// pressed.  Unknown scancodes are never pressed.
+func (ks KeyboardSnapshot) IsPressed(code keys.Scancode) bool {
+	return code >= 0 && int(code) < len(ks) && ks[code]
+}
+
+// KeyboardState returns a snapshot of the current keyboard state.  The
+// state is updated by processing events, so call it after PollEvent.
+func KeyboardState() KeyboardSnapshot {
+	var n C.int
+	state := C.SDL_GetKeyboardState(&n)
+	ks := make(KeyboardSnapshot, int(n))
+	for i, v := range unsafe.Slice((*uint8)(unsafe.Pointer(state)), int(n)) {
+		ks[i] = v != 0
+	}
+	return ks
+}
+
+// ModState returns the current keyboard modifiers.
+func ModState() keys.Mod {
+	return keys.Mod(C.SDL_GetModState())
+}
+
+// SetModState sets the current keyboard modifiers.  This does not
+// change the keyboard's state, only the modifiers SDL reports.
+func SetModState(mod keys.Mod) {
+	C.SDL_SetModState(C.SDL_Keymod(mod))
+}
+
+// KeyboardFocus returns the window with keyboard focus or nil if no
+// window has focus.
+func KeyboardFocus() *Window {
+	return (*Window)(unsafe.Pointer(C.SDL_GetKeyboardFocus()))
+}
+
+// StartTextInput starts sending TextInputEvent and TextEditingEvent
+// events, showing the on-screen keyboard or input method if needed.
+// Text input is already started when video is initialized on platforms
+// without an on-screen keyboard.
+func StartTextInput() {
+	C.SDL_StartTextInput()
+}
+
+// StopTextInput stops sending text input events.
+func StopTextInput() {
+	C.SDL_StopTextInput()
+}
+
+// IsTextInputActive reports whether text input events are enabled.
+func IsTextInputActive() bool {
+	return C.SDL_IsTextInputActive() == C.SDL_TRUE
+}
+
+// SetTextInputRect sets the area where text is being entered, which an
+// input method may use to position its candidate list.
+func SetTextInputRect(r Rectangle) {
+	C.SDL_SetTextInputRect(r.toCRect())
+}
@@ -1,6 +1,19 @@
 package sdl
 
 // #include "SDL.h"
+//
+// // Byte-order formats, defined by SDL itself since 2.0.5.
+// #if SDL_BYTEORDER == SDL_BIG_ENDIAN
+// #define GOSDL_PIXELFORMAT_RGBA32 SDL_PIXELFORMAT_RGBA8888
+// #define GOSDL_PIXELFORMAT_ARGB32 SDL_PIXELFORMAT_ARGB8888
+// #define GOSDL_PIXELFORMAT_BGRA32 SDL_PIXELFORMAT_BGRA8888
+// #define GOSDL_PIXELFORMAT_ABGR32 SDL_PIXELFORMAT_ABGR8888
+// #else
+// #define GOSDL_PIXELFORMAT_RGBA32 SDL_PIXELFORMAT_ABGR8888
+// #define GOSDL_PIXELFORMAT_ARGB32 SDL_PIXELFORMAT_BGRA8888
+// #define GOSDL_PIXELFORMAT_BGRA32 SDL_PIXELFORMAT_ARGB8888
+// #define GOSDL_PIXELFORMAT_ABGR32 SDL_PIXELFORMAT_RGBA8888
+// #endif
 import "C"
 
 // PixelFormat describes a surface's pixel memory format.
@@ -125,6 +138,16 @@ const (
 	PixelFormatYVYU        PixelFormatEnum = C.SDL_PIXELFORMAT_YVYU
 )
 
+// Aliases for formats whose components are stored in the given byte
+// order, regardless of the platform's endianness.  PixelFormatRGBA32
+// has the same memory layout as image.RGBA and image.NRGBA.
+const (
+	PixelFormatRGBA32 PixelFormatEnum = C.GOSDL_PIXELFORMAT_RGBA32
+	PixelFormatARGB32 PixelFormatEnum = C.GOSDL_PIXELFORMAT_ARGB32
+	PixelFormatBGRA32 PixelFormatEnum = C.GOSDL_PIXELFORMAT_BGRA32
+	PixelFormatABGR32 PixelFormatEnum = C.GOSDL_PIXELFORMAT_ABGR32
+)
+
 // PixelType is a pixel format's data type.
 type PixelType uint8
 
@@ -64,3 +64,65 @@ func (r *Rectangle) toCRect() *C.SDL_Rect {
 		h: C.int(r.H),
 	}
 }
+
+// toCPoints converts a slice of points into a slice suitable for
+// passing to SDL's batch functions.
+func toCPoints(pts []Point) []C.SDL_Point {
+	cpts := make([]C.SDL_Point, len(pts))
+	for i, p := range pts {
+		cpts[i] = C.SDL_Point{x: C.int(p.X), y: C.int(p.Y)}
+	}
+	return cpts
+}
+
+// toCRects converts a slice of rectangles into a slice suitable for
+// passing to SDL's batch functions.
+func toCRects(rects []Rectangle) []C.SDL_Rect {
+	crects := make([]C.SDL_Rect, len(rects))
+	for i, r := range rects {
+		crects[i] = C.SDL_Rect{
+			x: C.int(r.Origin.X),
+			y: C.int(r.Origin.Y),
+			w: C.int(r.W),
+			h: C.int(r.H),
+		}
+	}
+	return crects
+}
+
+// FPoint is a two-dimensional point with floating point precision.
+type FPoint struct {
+	X, Y float32
+}
+
+// FPt is shorthand for FPoint{x, y}.
+func FPt(x, y float32) FPoint {
+	return FPoint{X: x, Y: y}
+}
+
+// String returns a string representation of p like "(3.5, 4)".
+func (p FPoint) String() string {
+	return fmt.Sprintf("(%g, %g)", p.X, p.Y)
+}
+
+// FRectangle is a rectangle with floating point precision, with the
+// origin at the upper left.
+type FRectangle struct {
+	Origin FPoint
+	W, H   float32
+}
+
+// FRect is shorthand for FRectangle{FPt(x, y), w, h}
+func FRect(x, y, w, h float32) FRectangle {
+	return FRectangle{Origin: FPt(x, y), W: w, H: h}
+}
+
+// String returns a string representation of r like "(3.5, 4) 5x7.25".
+func (r FRectangle) String() string {
+	return fmt.Sprintf("%v %gx%g", r.Origin, r.W, r.H)
+}
+
+// IsEmpty reports whether width <= 0 or height <= 0.
+func (r FRectangle) IsEmpty() bool {
+	return r.W <= 0 || r.H <= 0
+}
@@ -0,0 +1,404 @@
+package sdl
+
+// #include "SDL.h"
+//
+// #if !SDL_VERSION_ATLEAST(2,0,10)
+// typedef struct SDL_FPoint { float x; float y; } SDL_FPoint;
+// typedef struct SDL_FRect { float x; float y; float w; float h; } SDL_FRect;
+// #endif
+//
+// static int renderCopyExF(SDL_Renderer *r, SDL_Texture *t, const SDL_Rect *src, const SDL_FRect *dst, double angle, const SDL_FPoint *center, SDL_RendererFlip flip) {
+// #if SDL_VERSION_ATLEAST(2,0,10)
+// 	return SDL_RenderCopyExF(r, t, src, dst, angle, center, flip);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+//
+// static SDL_bool renderIsClipEnabled(SDL_Renderer *r) {
+// #if SDL_VERSION_ATLEAST(2,0,4)
+// 	return SDL_RenderIsClipEnabled(r);
+// #else
+// 	SDL_Rect rect;
+// 	SDL_RenderGetClipRect(r, &rect);
+// 	return !SDL_RectEmpty(&rect);
+// #endif
+// }
+//
+// static int renderSetIntegerScale(SDL_Renderer *r, SDL_bool enable) {
+// #if SDL_VERSION_ATLEAST(2,0,5)
+// 	return SDL_RenderSetIntegerScale(r, enable);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+//
+// static SDL_bool renderGetIntegerScale(SDL_Renderer *r) {
+// #if SDL_VERSION_ATLEAST(2,0,5)
+// 	return SDL_RenderGetIntegerScale(r);
+// #else
+// 	return SDL_FALSE;
+// #endif
+// }
+import "C"
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"unsafe"
+)
+
+// BlendMode describes how colors are combined when drawing.
+type BlendMode uint32
+
+// Blend modes.
+const (
+	// No blending: dst = src
+	BlendModeNone BlendMode = C.SDL_BLENDMODE_NONE
+	// Alpha blending: dst = src*srcA + dst*(1-srcA)
+	BlendModeBlend BlendMode = C.SDL_BLENDMODE_BLEND
+	// Additive blending: dst = src*srcA + dst
+	BlendModeAdd BlendMode = C.SDL_BLENDMODE_ADD
+	// Color modulate: dst = src*dst
+	BlendModeMod BlendMode = C.SDL_BLENDMODE_MOD
+)
+
+// String returns the blend mode's name like "BlendModeBlend".
+func (mode BlendMode) String() string {
+	switch mode {
+	case BlendModeNone:
+		return "BlendModeNone"
+	case BlendModeBlend:
+		return "BlendModeBlend"
+	case BlendModeAdd:
+		return "BlendModeAdd"
+	case BlendModeMod:
+		return "BlendModeMod"
+	default:
+		return fmt.Sprintf("BlendMode(%d)", uint32(mode))
+	}
+}
+
+// SetDrawColor sets the color used for drawing operations (Clear,
+// DrawLine, DrawRect, etc.).
+func (r *Renderer) SetDrawColor(c color.Color) error {
+	col := color.NRGBAModel.Convert(c).(color.NRGBA)
+	if C.SDL_SetRenderDrawColor(&r.r, C.Uint8(col.R), C.Uint8(col.G), C.Uint8(col.B), C.Uint8(col.A)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// DrawColor returns the color used for drawing operations.
+func (r *Renderer) DrawColor() (color.NRGBA, error) {
+	var red, green, blue, alpha C.Uint8
+	if C.SDL_GetRenderDrawColor(&r.r, &red, &green, &blue, &alpha) != 0 {
+		return color.NRGBA{}, GetError()
+	}
+	return color.NRGBA{R: uint8(red), G: uint8(green), B: uint8(blue), A: uint8(alpha)}, nil
+}
+
+// SetDrawBlendMode sets the blend mode used for drawing operations
+// (Fill and Line).
+func (r *Renderer) SetDrawBlendMode(mode BlendMode) error {
+	if C.SDL_SetRenderDrawBlendMode(&r.r, C.SDL_BlendMode(mode)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// DrawBlendMode returns the blend mode used for drawing operations.
+func (r *Renderer) DrawBlendMode() (BlendMode, error) {
+	var mode C.SDL_BlendMode
+	if C.SDL_GetRenderDrawBlendMode(&r.r, &mode) != 0 {
+		return BlendModeNone, GetError()
+	}
+	return BlendMode(mode), nil
+}
+
+// DrawPoint draws a point with the drawing color.
+func (r *Renderer) DrawPoint(p Point) error {
+	if C.SDL_RenderDrawPoint(&r.r, C.int(p.X), C.int(p.Y)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// DrawPoints draws multiple points with the drawing color.
+func (r *Renderer) DrawPoints(pts []Point) error {
+	if len(pts) == 0 {
+		return nil
+	}
+	cpts := toCPoints(pts)
+	if C.SDL_RenderDrawPoints(&r.r, &cpts[0], C.int(len(cpts))) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// DrawLine draws a line from p1 to p2 with the drawing color.
+func (r *Renderer) DrawLine(p1, p2 Point) error {
+	if C.SDL_RenderDrawLine(&r.r, C.int(p1.X), C.int(p1.Y), C.int(p2.X), C.int(p2.Y)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// DrawLines draws a series of connected lines with the drawing color.
+func (r *Renderer) DrawLines(pts []Point) error {
+	if len(pts) == 0 {
+		return nil
+	}
+	cpts := toCPoints(pts)
+	if C.SDL_RenderDrawLines(&r.r, &cpts[0], C.int(len(cpts))) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// DrawRect draws the outline of a rectangle with the drawing color.
+// A nil rect outlines the entire rendering target.
+func (r *Renderer) DrawRect(rect *Rectangle) error {
+	if C.SDL_RenderDrawRect(&r.r, rect.toCRect()) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// DrawRects draws the outlines of multiple rectangles with the drawing color.
+func (r *Renderer) DrawRects(rects []Rectangle) error {
+	if len(rects) == 0 {
+		return nil
+	}
+	crects := toCRects(rects)
+	if C.SDL_RenderDrawRects(&r.r, &crects[0], C.int(len(crects))) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// FillRect fills a rectangle with the drawing color.
+// A nil rect fills the entire rendering target.
+func (r *Renderer) FillRect(rect *Rectangle) error {
+	if C.SDL_RenderFillRect(&r.r, rect.toCRect()) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// FillRects fills multiple rectangles with the drawing color.
+func (r *Renderer) FillRects(rects []Rectangle) error {
+	if len(rects) == 0 {
+		return nil
+	}
+	crects := toCRects(rects)
+	if C.SDL_RenderFillRects(&r.r, &crects[0], C.int(len(crects))) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// RendererFlip describes how a texture is mirrored when copied.
+type RendererFlip uint32
+
+// Flip values.  Multiple flips may be ORed together.
+const (
+	FlipNone       RendererFlip = C.SDL_FLIP_NONE
+	FlipHorizontal RendererFlip = C.SDL_FLIP_HORIZONTAL
+	FlipVertical   RendererFlip = C.SDL_FLIP_VERTICAL
+)
+
+// CopyTextureEx copies a portion of the texture to the current
+// rendering context, rotating it by angle degrees clockwise around
+// center and optionally flipping it.  A nil center rotates around the
+// center of destRect.
+func (r *Renderer) CopyTextureEx(texture *Texture, srcRect, destRect *Rectangle, angle float64, center *Point, flip RendererFlip) error {
+	if C.SDL_RenderCopyEx(&r.r, &texture.t, srcRect.toCRect(), destRect.toCRect(), C.double(angle), center.toCPoint(), C.SDL_RendererFlip(flip)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// CopyTextureExF is like CopyTextureEx, but the destination and center
+// have sub-pixel precision.  It requires SDL 2.0.10 or later and returns
+// an error otherwise.
+func (r *Renderer) CopyTextureExF(texture *Texture, srcRect *Rectangle, destRect *FRectangle, angle float64, center *FPoint, flip RendererFlip) error {
+	if C.renderCopyExF(&r.r, &texture.t, srcRect.toCRect(), destRect.toCFRect(), C.double(angle), center.toCFPoint(), C.SDL_RendererFlip(flip)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// toCFPoint is defined here instead of rect.go because SDL_FPoint
+// needs the compatibility typedef above on older versions of SDL.
+func (p *FPoint) toCFPoint() *C.SDL_FPoint {
+	if p == nil {
+		return nil
+	}
+	return &C.SDL_FPoint{
+		x: C.float(p.X),
+		y: C.float(p.Y),
+	}
+}
+
+func (r *FRectangle) toCFRect() *C.SDL_FRect {
+	if r == nil {
+		return nil
+	}
+	return &C.SDL_FRect{
+		x: C.float(r.Origin.X),
+		y: C.float(r.Origin.Y),
+		w: C.float(r.W),
+		h: C.float(r.H),
+	}
+}
+
+// SetViewport sets the drawing area for rendering on the current
+// target.  A nil rect sets the viewport to the entire target.
+func (r *Renderer) SetViewport(rect *Rectangle) error {
+	if C.SDL_RenderSetViewport(&r.r, rect.toCRect()) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// Viewport returns the drawing area for the current target.
+func (r *Renderer) Viewport() Rectangle {
+	var rect C.SDL_Rect
+	C.SDL_RenderGetViewport(&r.r, &rect)
+	return Rect(int(rect.x), int(rect.y), int(rect.w), int(rect.h))
+}
+
+// SetClipRect sets the clip rectangle for rendering on the current
+// target.  A nil rect disables clipping.
+func (r *Renderer) SetClipRect(rect *Rectangle) error {
+	if C.SDL_RenderSetClipRect(&r.r, rect.toCRect()) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// ClipRect returns the clip rectangle for the current target.  The
+// rectangle is empty if clipping is disabled.
+func (r *Renderer) ClipRect() Rectangle {
+	var rect C.SDL_Rect
+	C.SDL_RenderGetClipRect(&r.r, &rect)
+	return Rect(int(rect.x), int(rect.y), int(rect.w), int(rect.h))
+}
+
+// IsClipEnabled reports whether clipping is enabled.
+func (r *Renderer) IsClipEnabled() bool {
+	return C.renderIsClipEnabled(&r.r) == C.SDL_TRUE
+}
+
+// SetLogicalSize sets a device-independent resolution for rendering.
+// The renderer scales and letterboxes its output to fit the target
+// while keeping the aspect ratio.  A size of 0x0 disables logical
+// sizing.
+func (r *Renderer) SetLogicalSize(w, h int) error {
+	if C.SDL_RenderSetLogicalSize(&r.r, C.int(w), C.int(h)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// LogicalSize returns the device-independent resolution for rendering,
+// or 0x0 if logical sizing is disabled.
+func (r *Renderer) LogicalSize() (int, int) {
+	var w, h C.int
+	C.SDL_RenderGetLogicalSize(&r.r, &w, &h)
+	return int(w), int(h)
+}
+
+// SetIntegerScale forces the logical size to be scaled by integer
+// amounts only.  It requires SDL 2.0.5 or later and returns an error
+// otherwise.
+func (r *Renderer) SetIntegerScale(enable bool) error {
+	if C.renderSetIntegerScale(&r.r, cBool(enable)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// IntegerScale reports whether integer scaling is forced.
+func (r *Renderer) IntegerScale() bool {
+	return C.renderGetIntegerScale(&r.r) == C.SDL_TRUE
+}
+
+// SetScale sets the drawing scale for rendering on the current target.
+// Coordinates are multiplied by the scale factors before rendering.
+func (r *Renderer) SetScale(scaleX, scaleY float32) error {
+	if C.SDL_RenderSetScale(&r.r, C.float(scaleX), C.float(scaleY)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// Scale returns the drawing scale for the current target.
+func (r *Renderer) Scale() (scaleX, scaleY float32) {
+	var x, y C.float
+	C.SDL_RenderGetScale(&r.r, &x, &y)
+	return float32(x), float32(y)
+}
+
+// OutputSize returns the output size of the renderer in pixels.  On
+// high-DPI displays this may be larger than the window's Size.
+func (r *Renderer) OutputSize() (int, int, error) {
+	var w, h C.int
+	if C.SDL_GetRendererOutputSize(&r.r, &w, &h) != 0 {
+		return 0, 0, GetError()
+	}
+	return int(w), int(h), nil
+}
+
+// ReadPixels reads a rectangle of pixels from the current rendering
+// target into pixels, converting them to format.  rect is in output
+// pixels, ignoring the renderer's scale, and pitch is the number of
+// bytes in a row of pixels.  A nil rect reads the entire viewport.
+// This is a very slow operation and should not be used frequently.
+func (r *Renderer) ReadPixels(rect *Rectangle, format PixelFormatEnum, pixels []byte, pitch int) error {
+	if rect == nil {
+		vp := r.outputViewport()
+		rect = &vp
+	}
+	if rect.IsEmpty() {
+		return nil
+	}
+	if n := (rect.H-1)*pitch + rect.W*format.BytesPerPixel(); len(pixels) < n {
+		return Error(fmt.Sprintf("ReadPixels buffer too small: %d bytes, need %d", len(pixels), n))
+	}
+	if C.SDL_RenderReadPixels(&r.r, rect.toCRect(), C.Uint32(format), unsafe.Pointer(&pixels[0]), C.int(pitch)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// ReadNRGBA reads a rectangle of pixels from the current rendering
+// target into a new image.  rect is in output pixels, as in ReadPixels.
+// A nil rect reads the entire viewport.
+func (r *Renderer) ReadNRGBA(rect *Rectangle) (*image.NRGBA, error) {
+	if rect == nil {
+		vp := r.outputViewport()
+		rect = &vp
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, rect.W, rect.H))
+	if err := r.ReadPixels(rect, PixelFormatRGBA32, img.Pix, img.Stride); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// outputViewport returns the viewport in output pixels.  Viewport
+// reports the viewport divided by the render scale, so it is scaled
+// back up here, rounding outward so that no pixels are lost.
+func (r *Renderer) outputViewport() Rectangle {
+	vp := r.Viewport()
+	sx, sy := r.Scale()
+	return Rect(
+		int(math.Floor(float64(vp.Origin.X)*float64(sx))),
+		int(math.Floor(float64(vp.Origin.Y)*float64(sy))),
+		int(math.Ceil(float64(vp.W)*float64(sx))),
+		int(math.Ceil(float64(vp.H)*float64(sy))))
+}
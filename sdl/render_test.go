@@ -0,0 +1,58 @@
+package sdl
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestReadNRGBAScaled(t *testing.T) {
+	surface, err := NewSurface(16, 16, PixelFormatARGB8888)
+	if err != nil {
+		t.Fatal("NewSurface:", err)
+	}
+	defer surface.Destroy()
+	r, err := surface.CreateRenderer()
+	if err != nil {
+		t.Fatal("CreateRenderer:", err)
+	}
+	defer r.Destroy()
+
+	black := color.NRGBA{0, 0, 0, 0xff}
+	red := color.NRGBA{0xff, 0, 0, 0xff}
+	if err := r.SetDrawColor(black); err != nil {
+		t.Fatal("SetDrawColor:", err)
+	}
+	if err := r.Clear(); err != nil {
+		t.Fatal("Clear:", err)
+	}
+	if err := r.SetScale(2, 2); err != nil {
+		t.Fatal("SetScale:", err)
+	}
+	if err := r.SetDrawColor(red); err != nil {
+		t.Fatal("SetDrawColor:", err)
+	}
+	// (1, 1) 2x2 at scale 2 covers output pixels (2, 2) to (5, 5).
+	if err := r.FillRect(&Rectangle{Origin: Pt(1, 1), W: 2, H: 2}); err != nil {
+		t.Fatal("FillRect:", err)
+	}
+
+	img, err := r.ReadNRGBA(nil)
+	if err != nil {
+		t.Fatal("ReadNRGBA:", err)
+	}
+	if want := image.Rect(0, 0, 16, 16); img.Bounds() != want {
+		t.Fatalf("ReadNRGBA(nil).Bounds() = %v; want %v", img.Bounds(), want)
+	}
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			want := black
+			if x >= 2 && x < 6 && y >= 2 && y < 6 {
+				want = red
+			}
+			if got := img.NRGBAAt(x, y); got != want {
+				t.Errorf("pixel (%d, %d) = %v; want %v", x, y, got, want)
+			}
+		}
+	}
+}
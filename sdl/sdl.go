@@ -25,6 +25,16 @@ sdl.Main.
 		})
 	}
 
+Audio Callbacks
+
+An AudioSpec's Callback runs on a thread owned by SDL, concurrently with
+the rest of the program.  It must not call Do or AudioDevice.Close:
+either would wait on a thread that may be waiting on the callback, so
+both panic when called from a callback.  Most other SDL functions are
+not safe to call from a callback either.  Data shared with a callback
+should be protected with AudioDevice.Lock and Unlock or with the sync
+package, and the callback should avoid blocking or allocating.
+
 Pointers And Destruction
 
 These bindings will return pointers to the actual underlying SDL
@@ -72,6 +82,9 @@ var mainFunc = make(chan func())
 // Do executes a function on the main thread.  Calls to Do cannot nest --
 // calling Do inside of a function passed to Do will cause deadlock.
 func Do(f func()) {
+	if inAudioCallback() {
+		panic("sdl: Do called from an audio callback")
+	}
 	// Taken from https://code.google.com/p/go-wiki/wiki/LockOSThread.
 	done := make(chan bool, 1)
 	mainFunc <- func() {
@@ -117,6 +130,23 @@ func Quit() {
 	close(mainFunc)
 }
 
+// cBool converts a Go bool to an SDL_bool.
+func cBool(b bool) C.SDL_bool {
+	if b {
+		return C.SDL_TRUE
+	}
+	return C.SDL_FALSE
+}
+
+// cBoolInt converts a Go bool to a C int for SDL functions that take an
+// int flag.
+func cBoolInt(b bool) C.int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 // Error stores an SDL error.
 type Error string
 
@@ -14,6 +14,21 @@ type Surface struct {
 	s C.SDL_Surface
 }
 
+// NewSurface creates a new surface of the given size and format.
+// The surface's pixels are initialized to zero.
+func NewSurface(w, h int, format PixelFormatEnum) (*Surface, error) {
+	var bpp C.int
+	var rmask, gmask, bmask, amask C.Uint32
+	if C.SDL_PixelFormatEnumToMasks(C.Uint32(format), &bpp, &rmask, &gmask, &bmask, &amask) == C.SDL_FALSE {
+		return nil, GetError()
+	}
+	s := C.SDL_CreateRGBSurface(0, C.int(w), C.int(h), bpp, rmask, gmask, bmask, amask)
+	if s == nil {
+		return nil, GetError()
+	}
+	return (*Surface)(unsafe.Pointer(s)), nil
+}
+
 // PixelFormat returns the surface's pixel format.
 func (surface *Surface) PixelFormat() *PixelFormat {
 	return &PixelFormat{
@@ -43,6 +58,17 @@ func (surface *Surface) PixelData() (PixelData, error) {
 	return PixelData{s: &surface.s}, nil
 }
 
+// CreateRenderer creates a software renderer that draws into the
+// surface.  No window is required.  The renderer must be destroyed
+// before the surface.
+func (surface *Surface) CreateRenderer() (*Renderer, error) {
+	r := C.SDL_CreateSoftwareRenderer(&surface.s)
+	if r == nil {
+		return nil, GetError()
+	}
+	return (*Renderer)(unsafe.Pointer(r)), nil
+}
+
 // Destroy destroys the surface.  The surface should not be used after
 // a call to Destroy.
 func (surface *Surface) Destroy() {
@@ -75,8 +101,11 @@ func (pix PixelData) pixel(x, y int) unsafe.Pointer {
 
 // At returns the pixel at the given position.
 func (pix PixelData) At(x, y int) color.Color {
-	format := pix.s.format
-	ptr := pix.pixel(x, y)
+	return readPixel(pix.s.format, pix.pixel(x, y))
+}
+
+// readPixel decodes the pixel stored at ptr in the given format.
+func readPixel(format *C.SDL_PixelFormat, ptr unsafe.Pointer) color.Color {
 	// TODO(adam): not necesarily NRGBA (which would be an entirely different codepath)
 	var col color.NRGBA
 
@@ -118,12 +147,16 @@ func collapseColor(color uint8, shift, loss C.Uint8) uint32 {
 
 // Set sets the color at an x, y position in the PixelData to a given color.
 func (pix PixelData) Set(x, y int, c color.Color) {
-	format := pix.s.format
+	writePixel(pix.s.format, pix.pixel(x, y), c)
+}
+
+// writePixel encodes c into the pixel stored at ptr in the given format.
+func writePixel(format *C.SDL_PixelFormat, ptr unsafe.Pointer, c color.Color) {
 	switch format.BytesPerPixel {
 	case 4:
-		col := pix.ColorModel().Convert(c).(color.NRGBA)
+		col := color.NRGBAModel.Convert(c).(color.NRGBA)
 
-		p := (*uint32)(pix.pixel(x, y))
+		p := (*uint32)(ptr)
 		*p = collapseColor(col.R, format.Rshift, format.Rloss)
 		*p |= collapseColor(col.G, format.Gshift, format.Gloss)
 		*p |= collapseColor(col.B, format.Bshift, format.Bloss)
@@ -4,6 +4,9 @@ package sdl
 import "C"
 
 import (
+	"fmt"
+	"image"
+	"image/color"
 	"unsafe"
 )
 
@@ -12,6 +15,29 @@ type Texture struct {
 	t C.SDL_Texture
 }
 
+// TextureAccess describes how a texture's pixels may be modified.
+type TextureAccess int
+
+// Texture access patterns.
+const (
+	// Changes rarely, not lockable
+	TextureAccessStatic TextureAccess = C.SDL_TEXTUREACCESS_STATIC
+	// Changes frequently, lockable
+	TextureAccessStreaming TextureAccess = C.SDL_TEXTUREACCESS_STREAMING
+	// Can be used as a render target
+	TextureAccessTarget TextureAccess = C.SDL_TEXTUREACCESS_TARGET
+)
+
+// NewTexture creates a new texture for a rendering context.
+// The texture's contents are undefined when it is created.
+func (r *Renderer) NewTexture(format PixelFormatEnum, access TextureAccess, w, h int) (*Texture, error) {
+	tex := C.SDL_CreateTexture(&r.r, C.Uint32(format), C.int(access), C.int(w), C.int(h))
+	if tex == nil {
+		return nil, GetError()
+	}
+	return (*Texture)(unsafe.Pointer(tex)), nil
+}
+
 // NewTextureFromSurface creates a new texture from an existing surface.
 func NewTextureFromSurface(renderer *Renderer, surface *Surface) (*Texture, error) {
 	tex := C.SDL_CreateTextureFromSurface(&renderer.r, &surface.s)
@@ -21,8 +47,283 @@ func NewTextureFromSurface(renderer *Renderer, surface *Surface) (*Texture, erro
 	return (*Texture)(unsafe.Pointer(tex)), nil
 }
 
+// SetTarget sets a texture as the current rendering target.  The
+// texture must have been created with TextureAccessTarget.  A nil
+// texture restores the default rendering target.
+func (r *Renderer) SetTarget(t *Texture) error {
+	var ct *C.SDL_Texture
+	if t != nil {
+		ct = &t.t
+	}
+	if C.SDL_SetRenderTarget(&r.r, ct) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// Target returns the current rendering target or nil for the default
+// rendering target.
+func (r *Renderer) Target() *Texture {
+	return (*Texture)(unsafe.Pointer(C.SDL_GetRenderTarget(&r.r)))
+}
+
+// TextureInfo describes the attributes of a texture.
+type TextureInfo struct {
+	Format PixelFormatEnum
+	Access TextureAccess
+	Size   Point
+}
+
+// Query returns the texture's format, access pattern and size.
+func (t *Texture) Query() (*TextureInfo, error) {
+	var format C.Uint32
+	var access, w, h C.int
+	if C.SDL_QueryTexture(&t.t, &format, &access, &w, &h) != 0 {
+		return nil, GetError()
+	}
+	return &TextureInfo{
+		Format: PixelFormatEnum(format),
+		Access: TextureAccess(access),
+		Size:   Point{int(w), int(h)},
+	}, nil
+}
+
+// SetColorMod sets a color value multiplied into copy operations.
+// The alpha component of c is ignored; see SetAlphaMod.
+func (t *Texture) SetColorMod(c color.Color) error {
+	col := color.NRGBAModel.Convert(c).(color.NRGBA)
+	if C.SDL_SetTextureColorMod(&t.t, C.Uint8(col.R), C.Uint8(col.G), C.Uint8(col.B)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// ColorMod returns the color value multiplied into copy operations.
+// The returned color is always opaque.
+func (t *Texture) ColorMod() (color.NRGBA, error) {
+	var r, g, b C.Uint8
+	if C.SDL_GetTextureColorMod(&t.t, &r, &g, &b) != 0 {
+		return color.NRGBA{}, GetError()
+	}
+	return color.NRGBA{R: uint8(r), G: uint8(g), B: uint8(b), A: 0xff}, nil
+}
+
+// SetAlphaMod sets an alpha value multiplied into copy operations.
+func (t *Texture) SetAlphaMod(alpha uint8) error {
+	if C.SDL_SetTextureAlphaMod(&t.t, C.Uint8(alpha)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// AlphaMod returns the alpha value multiplied into copy operations.
+func (t *Texture) AlphaMod() (uint8, error) {
+	var alpha C.Uint8
+	if C.SDL_GetTextureAlphaMod(&t.t, &alpha) != 0 {
+		return 0, GetError()
+	}
+	return uint8(alpha), nil
+}
+
+// SetBlendMode sets the blend mode used for copy operations.
+func (t *Texture) SetBlendMode(mode BlendMode) error {
+	if C.SDL_SetTextureBlendMode(&t.t, C.SDL_BlendMode(mode)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// BlendMode returns the blend mode used for copy operations.
+func (t *Texture) BlendMode() (BlendMode, error) {
+	var mode C.SDL_BlendMode
+	if C.SDL_GetTextureBlendMode(&t.t, &mode) != 0 {
+		return BlendModeNone, GetError()
+	}
+	return BlendMode(mode), nil
+}
+
+// Update replaces a rectangle of the texture with new pixel data.
+// pixels must be in the texture's format and pitch is the number of
+// bytes in a row of pixel data.  A nil rect updates the entire texture;
+// otherwise rect must lie within the texture.  For planar YUV formats,
+// pixels must include the chroma planes after the Y plane.  This is a
+// fairly slow function, intended for use with static textures that do
+// not change often.
+func (t *Texture) Update(rect *Rectangle, pixels []byte, pitch int) error {
+	info, err := t.Query()
+	if err != nil {
+		return err
+	}
+	r, err := info.rect(rect)
+	if err != nil {
+		return err
+	}
+	n := info.dataSize(r, pitch, true)
+	if n == 0 {
+		return nil
+	}
+	if len(pixels) < n {
+		return Error(fmt.Sprintf("Update buffer too small: %d bytes, need %d", len(pixels), n))
+	}
+	if C.SDL_UpdateTexture(&t.t, rect.toCRect(), unsafe.Pointer(&pixels[0]), C.int(pitch)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// Lock locks a rectangle of a streaming texture for write-only pixel
+// access and returns a TextureData value to modify it.  A nil rect
+// locks the entire texture; otherwise rect must lie within the texture.
+// The returned TextureData must be closed to upload the changes before
+// the texture can be used again.
+//
+// Lock only supports 32-bit formats with 8 bits per channel.  Use
+// LockBytes for other formats, including YUV, or to write raw pixel
+// data.
+func (t *Texture) Lock(rect *Rectangle) (TextureData, error) {
+	info, err := t.Query()
+	if err != nil {
+		return TextureData{}, err
+	}
+	if info.Format.IsFourCC() || info.Format.Layout() != PackedLayout8888 {
+		return TextureData{}, Error("Lock: pixel format " + info.Format.String() + " not supported, use LockBytes")
+	}
+	r, err := info.rect(rect)
+	if err != nil {
+		return TextureData{}, err
+	}
+
+	pf := C.SDL_AllocFormat(C.Uint32(info.Format))
+	if pf == nil {
+		return TextureData{}, GetError()
+	}
+	var pixels unsafe.Pointer
+	var pitch C.int
+	if C.SDL_LockTexture(&t.t, rect.toCRect(), &pixels, &pitch) != 0 {
+		C.SDL_FreeFormat(pf)
+		return TextureData{}, GetError()
+	}
+	return TextureData{
+		t:      &t.t,
+		format: pf,
+		pixels: pixels,
+		pitch:  int(pitch),
+		bounds: image.Rect(0, 0, r.Dx(), r.Dy()),
+	}, nil
+}
+
+// LockBytes locks a rectangle of a streaming texture for write-only
+// access to its raw pixel data in the texture's format.  pitch is the
+// number of bytes in a row.  A nil rect locks the entire texture;
+// otherwise rect must lie within the texture.  For planar YUV formats,
+// only a full lock includes the chroma planes, which follow the Y plane.  Unlock must be called to upload the
+// changes before the texture can be used again, and pix must not be
+// used after that.
+func (t *Texture) LockBytes(rect *Rectangle) (pix []byte, pitch int, err error) {
+	info, err := t.Query()
+	if err != nil {
+		return nil, 0, err
+	}
+	r, err := info.rect(rect)
+	if err != nil {
+		return nil, 0, err
+	}
+	var pixels unsafe.Pointer
+	var cpitch C.int
+	if C.SDL_LockTexture(&t.t, rect.toCRect(), &pixels, &cpitch) != 0 {
+		return nil, 0, GetError()
+	}
+	pitch = int(cpitch)
+	return unsafe.Slice((*byte)(pixels), info.dataSize(r, pitch, rect == nil)), pitch, nil
+}
+
+// Unlock unlocks a texture locked with LockBytes, uploading any changes.
+func (t *Texture) Unlock() {
+	C.SDL_UnlockTexture(&t.t)
+}
+
+// rect returns rect in texture coordinates, or the entire texture if
+// rect is nil.  SDL does not clip the rectangles passed to
+// SDL_UpdateTexture and SDL_LockTexture, so rect must lie within the
+// texture.
+func (info *TextureInfo) rect(rect *Rectangle) (image.Rectangle, error) {
+	if rect == nil {
+		return image.Rect(0, 0, info.Size.X, info.Size.Y), nil
+	}
+	if rect.W < 0 || rect.H < 0 ||
+		rect.Origin.X < 0 || rect.Origin.X+rect.W > info.Size.X ||
+		rect.Origin.Y < 0 || rect.Origin.Y+rect.H > info.Size.Y {
+		return image.Rectangle{}, Error(fmt.Sprintf("rectangle %v outside %dx%d texture", *rect, info.Size.X, info.Size.Y))
+	}
+	return image.Rect(rect.Origin.X, rect.Origin.Y, rect.Origin.X+rect.W, rect.Origin.Y+rect.H), nil
+}
+
+// dataSize returns the number of bytes of pixel data covering r with
+// the given pitch.  If planes is true and the format is planar YUV, the
+// chroma planes following the Y plane are included.
+func (info *TextureInfo) dataSize(r image.Rectangle, pitch int, planes bool) int {
+	w, h := r.Dx(), r.Dy()
+	if w == 0 || h == 0 {
+		return 0
+	}
+	if planes && (info.Format == PixelFormatYV12 || info.Format == PixelFormatIYUV) {
+		// Two chroma planes at half resolution.
+		return h*pitch + 2*((h+1)/2)*((pitch+1)/2)
+	}
+	// The last row may end before the pitch does.
+	return (h-1)*pitch + w*info.Format.BytesPerPixel()
+}
+
 // Destroy destroys the texture.  The texture should not be used after
 // calling Destroy.
 func (t *Texture) Destroy() {
 	C.SDL_DestroyTexture(&t.t)
 }
+
+// TextureData is a write-only view of a locked texture's pixels.  The
+// pixels are not guaranteed to contain the texture's old contents, so
+// every pixel should be written.  The texture data should be closed to
+// allow the texture to be used again.
+//
+// TextureData implements the image.Image and draw.Image interfaces.
+// Its bounds are relative to the locked rectangle.
+type TextureData struct {
+	t      *C.SDL_Texture
+	format *C.SDL_PixelFormat
+	pixels unsafe.Pointer
+	pitch  int
+	bounds image.Rectangle
+}
+
+// pixel returns the address of the pixel at (x, y).
+func (data TextureData) pixel(x, y int) unsafe.Pointer {
+	offset := uintptr(y)*uintptr(data.pitch) + uintptr(x)*uintptr(data.format.BytesPerPixel)
+	return unsafe.Pointer(uintptr(data.pixels) + offset)
+}
+
+// At returns the pixel at the given position.
+func (data TextureData) At(x, y int) color.Color {
+	return readPixel(data.format, data.pixel(x, y))
+}
+
+// ColorModel returns the color model of the pixel data.
+func (data TextureData) ColorModel() color.Model {
+	return color.NRGBAModel
+}
+
+// Bounds returns a rectangle of (0,0) => (w,h) of the locked area.
+func (data TextureData) Bounds() image.Rectangle {
+	return data.bounds
+}
+
+// Set sets the color at an x, y position in the TextureData to a given color.
+func (data TextureData) Set(x, y int, c color.Color) {
+	writePixel(data.format, data.pixel(x, y), c)
+}
+
+// Destroy unlocks the underlying texture, uploading any changes.
+// data should not be used after calling Destroy.
+func (data TextureData) Destroy() {
+	C.SDL_UnlockTexture(data.t)
+	C.SDL_FreeFormat(data.format)
+}
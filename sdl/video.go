@@ -0,0 +1,236 @@
+package sdl
+
+// #include "SDL.h"
+//
+// static int getDisplayUsableBounds(int i, SDL_Rect *rect) {
+// #if SDL_VERSION_ATLEAST(2,0,5)
+// 	return SDL_GetDisplayUsableBounds(i, rect);
+// #else
+// 	return SDL_GetDisplayBounds(i, rect);
+// #endif
+// }
+//
+// static int getDisplayDPI(int i, float *ddpi, float *hdpi, float *vdpi) {
+// #if SDL_VERSION_ATLEAST(2,0,4)
+// 	return SDL_GetDisplayDPI(i, ddpi, hdpi, vdpi);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+//
+// static int videoInit(const char *name) {
+// 	if (name != NULL && SDL_setenv("SDL_VIDEODRIVER", name, 1) != 0) {
+// 		return SDL_SetError("could not set SDL_VIDEODRIVER");
+// 	}
+// 	return SDL_InitSubSystem(SDL_INIT_VIDEO);
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// NumVideoDrivers returns the number of video drivers compiled into SDL.
+func NumVideoDrivers() (int, error) {
+	n := C.SDL_GetNumVideoDrivers()
+	if n < 0 {
+		return 0, GetError()
+	}
+	return int(n), nil
+}
+
+// VideoDriver returns the name of the video driver at the given index,
+// like "x11" or "dummy".  The index is in the range [0, NumVideoDrivers()).
+func VideoDriver(index int) string {
+	return C.GoString(C.SDL_GetVideoDriver(C.int(index)))
+}
+
+// CurrentVideoDriver returns the name of the initialized video driver,
+// or the empty string if no driver has been initialized.
+func CurrentVideoDriver() string {
+	name := C.SDL_GetCurrentVideoDriver()
+	if name == nil {
+		return ""
+	}
+	return C.GoString(name)
+}
+
+// VideoInit initializes the video subsystem with the named video
+// driver by setting the SDL_VIDEODRIVER environment variable.  An empty
+// name selects the driver as Init does.  It is an error to call
+// VideoInit while the video subsystem is initialized.
+func VideoInit(driverName string) error {
+	if C.SDL_WasInit(C.SDL_INIT_VIDEO) != 0 {
+		return Error("VideoInit: video already initialized")
+	}
+	var cname *C.char
+	if driverName != "" {
+		cname = C.CString(driverName)
+		defer C.free(unsafe.Pointer(cname))
+	}
+	if C.videoInit(cname) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// VideoQuit shuts down the video subsystem started by VideoInit.
+func VideoQuit() {
+	C.SDL_QuitSubSystem(C.SDL_INIT_VIDEO)
+}
+
+// DisplayMode describes a display's resolution and refresh rate.
+type DisplayMode struct {
+	Format      PixelFormatEnum
+	W, H        int
+	RefreshRate int // in Hz, or zero if unspecified
+}
+
+func newDisplayMode(mode *C.SDL_DisplayMode) DisplayMode {
+	return DisplayMode{
+		Format:      PixelFormatEnum(mode.format),
+		W:           int(mode.w),
+		H:           int(mode.h),
+		RefreshRate: int(mode.refresh_rate),
+	}
+}
+
+func (mode *DisplayMode) toCDisplayMode() *C.SDL_DisplayMode {
+	if mode == nil {
+		return nil
+	}
+	return &C.SDL_DisplayMode{
+		format:       C.Uint32(mode.Format),
+		w:            C.int(mode.W),
+		h:            C.int(mode.H),
+		refresh_rate: C.int(mode.RefreshRate),
+	}
+}
+
+// NumVideoDisplays returns the number of available video displays.
+func NumVideoDisplays() (int, error) {
+	n := C.SDL_GetNumVideoDisplays()
+	if n < 0 {
+		return 0, GetError()
+	}
+	return int(n), nil
+}
+
+// DisplayName returns the name of the display at the given index.
+func DisplayName(displayIndex int) (string, error) {
+	name := C.SDL_GetDisplayName(C.int(displayIndex))
+	if name == nil {
+		return "", GetError()
+	}
+	return C.GoString(name), nil
+}
+
+// DisplayBounds returns the desktop area represented by a display.
+// The primary display is located at (0, 0).
+func DisplayBounds(displayIndex int) (Rectangle, error) {
+	var rect C.SDL_Rect
+	if C.SDL_GetDisplayBounds(C.int(displayIndex), &rect) != 0 {
+		return Rectangle{}, GetError()
+	}
+	return Rect(int(rect.x), int(rect.y), int(rect.w), int(rect.h)), nil
+}
+
+// DisplayUsableBounds returns the desktop area represented by a
+// display, excluding areas reserved by the system like menu bars and
+// docks.  Before SDL 2.0.5, this is the same as DisplayBounds.
+func DisplayUsableBounds(displayIndex int) (Rectangle, error) {
+	var rect C.SDL_Rect
+	if C.getDisplayUsableBounds(C.int(displayIndex), &rect) != 0 {
+		return Rectangle{}, GetError()
+	}
+	return Rect(int(rect.x), int(rect.y), int(rect.w), int(rect.h)), nil
+}
+
+// DisplayDPI returns the diagonal, horizontal and vertical dots per
+// inch of a display.  It requires SDL 2.0.4 or later and returns an
+// error otherwise.
+func DisplayDPI(displayIndex int) (ddpi, hdpi, vdpi float32, err error) {
+	var d, h, v C.float
+	if C.getDisplayDPI(C.int(displayIndex), &d, &h, &v) != 0 {
+		return 0, 0, 0, GetError()
+	}
+	return float32(d), float32(h), float32(v), nil
+}
+
+// DisplayModes returns the display modes available on a display,
+// sorted from largest to smallest.
+func DisplayModes(displayIndex int) ([]DisplayMode, error) {
+	n := C.SDL_GetNumDisplayModes(C.int(displayIndex))
+	if n < 0 {
+		return nil, GetError()
+	}
+	modes := make([]DisplayMode, n)
+	for i := range modes {
+		var mode C.SDL_DisplayMode
+		if C.SDL_GetDisplayMode(C.int(displayIndex), C.int(i), &mode) != 0 {
+			return nil, GetError()
+		}
+		modes[i] = newDisplayMode(&mode)
+	}
+	return modes, nil
+}
+
+// DesktopDisplayMode returns the display mode that was in use when
+// SDL started, before any fullscreen mode changes.
+func DesktopDisplayMode(displayIndex int) (DisplayMode, error) {
+	var mode C.SDL_DisplayMode
+	if C.SDL_GetDesktopDisplayMode(C.int(displayIndex), &mode) != 0 {
+		return DisplayMode{}, GetError()
+	}
+	return newDisplayMode(&mode), nil
+}
+
+// CurrentDisplayMode returns the display's current display mode.
+func CurrentDisplayMode(displayIndex int) (DisplayMode, error) {
+	var mode C.SDL_DisplayMode
+	if C.SDL_GetCurrentDisplayMode(C.int(displayIndex), &mode) != 0 {
+		return DisplayMode{}, GetError()
+	}
+	return newDisplayMode(&mode), nil
+}
+
+// ClosestDisplayMode returns the available display mode that most
+// closely matches mode.  A zero Format or RefreshRate in mode is
+// replaced with the desktop mode's value; W and H are used as given.
+func ClosestDisplayMode(displayIndex int, mode DisplayMode) (DisplayMode, error) {
+	var closest C.SDL_DisplayMode
+	if C.SDL_GetClosestDisplayMode(C.int(displayIndex), mode.toCDisplayMode(), &closest) == nil {
+		return DisplayMode{}, GetError()
+	}
+	return newDisplayMode(&closest), nil
+}
+
+// DisplayIndex returns the index of the display that contains the
+// center of the window.
+func (w *Window) DisplayIndex() (int, error) {
+	i := C.SDL_GetWindowDisplayIndex(&w.w)
+	if i < 0 {
+		return 0, GetError()
+	}
+	return int(i), nil
+}
+
+// SetDisplayMode sets the display mode used when the window is
+// fullscreen.  A nil mode uses the window's size and the desktop's
+// format and refresh rate.
+func (w *Window) SetDisplayMode(mode *DisplayMode) error {
+	if C.SDL_SetWindowDisplayMode(&w.w, mode.toCDisplayMode()) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// DisplayMode returns the display mode used when the window is
+// fullscreen.
+func (w *Window) DisplayMode() (DisplayMode, error) {
+	var mode C.SDL_DisplayMode
+	if C.SDL_GetWindowDisplayMode(&w.w, &mode) != 0 {
+		return DisplayMode{}, GetError()
+	}
+	return newDisplayMode(&mode), nil
+}
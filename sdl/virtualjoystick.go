@@ -0,0 +1,113 @@
+package sdl
+
+// #include "SDL.h"
+//
+// // Virtual joysticks were added in SDL 2.0.14.
+// static int joystickAttachVirtual(int naxes, int nbuttons, int nhats) {
+// #if SDL_VERSION_ATLEAST(2,0,14)
+// 	return SDL_JoystickAttachVirtual(SDL_JOYSTICK_TYPE_GAMECONTROLLER, naxes, nbuttons, nhats);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+//
+// static int joystickDetachVirtual(int index) {
+// #if SDL_VERSION_ATLEAST(2,0,14)
+// 	return SDL_JoystickDetachVirtual(index);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+//
+// static SDL_bool joystickIsVirtual(int index) {
+// #if SDL_VERSION_ATLEAST(2,0,14)
+// 	return SDL_JoystickIsVirtual(index);
+// #else
+// 	return SDL_FALSE;
+// #endif
+// }
+//
+// static int joystickSetVirtualAxis(SDL_Joystick *j, int axis, Sint16 value) {
+// #if SDL_VERSION_ATLEAST(2,0,14)
+// 	return SDL_JoystickSetVirtualAxis(j, axis, value);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+//
+// static int joystickSetVirtualButton(SDL_Joystick *j, int button, Uint8 value) {
+// #if SDL_VERSION_ATLEAST(2,0,14)
+// 	return SDL_JoystickSetVirtualButton(j, button, value);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+//
+// static int joystickSetVirtualHat(SDL_Joystick *j, int hat, Uint8 value) {
+// #if SDL_VERSION_ATLEAST(2,0,14)
+// 	return SDL_JoystickSetVirtualHat(j, hat, value);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+import "C"
+
+// AttachVirtualJoystick attaches a software joystick with the given
+// number of axes, buttons and hats and returns its device index.  The
+// joystick is attached as a game controller, so it can be opened with
+// either OpenJoystick or OpenGameController.  Virtual joysticks are
+// useful for testing input handling without hardware.  It requires SDL
+// 2.0.14 or later and returns an error otherwise.
+func AttachVirtualJoystick(axes, buttons, hats int) (int, error) {
+	index := C.joystickAttachVirtual(C.int(axes), C.int(buttons), C.int(hats))
+	if index < 0 {
+		return 0, GetError()
+	}
+	return int(index), nil
+}
+
+// DetachVirtualJoystick detaches the virtual joystick at the given
+// device index.
+func DetachVirtualJoystick(index int) error {
+	if C.joystickDetachVirtual(C.int(index)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// IsVirtualJoystick reports whether the joystick at the given device
+// index is a virtual joystick.
+func IsVirtualJoystick(index int) bool {
+	return C.joystickIsVirtual(C.int(index)) == C.SDL_TRUE
+}
+
+// SetVirtualAxis sets the position of an axis on an open virtual
+// joystick.  The change is reported through the event queue the next
+// time joysticks are updated, such as by PollEvent.
+func (j *Joystick) SetVirtualAxis(axis int, value int16) error {
+	if C.joystickSetVirtualAxis(&j.j, C.int(axis), C.Sint16(value)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// SetVirtualButton sets the state of a button on an open virtual
+// joystick.
+func (j *Joystick) SetVirtualButton(button int, pressed bool) error {
+	var value C.Uint8 = C.SDL_RELEASED
+	if pressed {
+		value = C.SDL_PRESSED
+	}
+	if C.joystickSetVirtualButton(&j.j, C.int(button), value) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// SetVirtualHat sets the position of a hat on an open virtual joystick.
+func (j *Joystick) SetVirtualHat(hat int, position HatPosition) error {
+	if C.joystickSetVirtualHat(&j.j, C.int(hat), C.Uint8(position)) != 0 {
+		return GetError()
+	}
+	return nil
+}
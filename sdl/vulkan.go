@@ -0,0 +1,91 @@
+//go:build vulkan
+// +build vulkan
+
+package sdl
+
+// #include <stdint.h>
+// #include "SDL.h"
+// #include "SDL_vulkan.h"
+//
+// static SDL_bool vulkanCreateSurface(SDL_Window *w, uintptr_t instance, uint64_t *surface) {
+// 	VkSurfaceKHR s;
+// 	SDL_bool ok = SDL_Vulkan_CreateSurface(w, (VkInstance)instance, &s);
+// 	*surface = (uint64_t)s;
+// 	return ok;
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// WindowVulkan is a window creation option for windows usable with a
+// Vulkan instance.  Vulkan support requires SDL 2.0.6 or later and
+// building with the vulkan tag.
+const WindowVulkan WindowFlag = C.SDL_WINDOW_VULKAN
+
+// VulkanLoadLibrary dynamically loads a Vulkan loader library.  An
+// empty path loads the default library.  This must be called before
+// creating a Vulkan window, if at all.
+func VulkanLoadLibrary(path string) error {
+	var cpath *C.char
+	if path != "" {
+		cpath = C.CString(path)
+		defer C.free(unsafe.Pointer(cpath))
+	}
+	if C.SDL_Vulkan_LoadLibrary(cpath) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// VulkanUnloadLibrary unloads the library loaded by VulkanLoadLibrary.
+func VulkanUnloadLibrary() {
+	C.SDL_Vulkan_UnloadLibrary()
+}
+
+// VulkanGetInstanceProcAddr returns the address of the loader's
+// vkGetInstanceProcAddr function, or zero if no library is loaded.
+func VulkanGetInstanceProcAddr() uintptr {
+	return uintptr(C.SDL_Vulkan_GetVkGetInstanceProcAddr())
+}
+
+// VulkanInstanceExtensions returns the names of the Vulkan instance
+// extensions needed to create a surface for the window.
+func (w *Window) VulkanInstanceExtensions() ([]string, error) {
+	var count C.uint
+	if C.SDL_Vulkan_GetInstanceExtensions(&w.w, &count, nil) == C.SDL_FALSE {
+		return nil, GetError()
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	names := make([]*C.char, count)
+	if C.SDL_Vulkan_GetInstanceExtensions(&w.w, &count, &names[0]) == C.SDL_FALSE {
+		return nil, GetError()
+	}
+	exts := make([]string, count)
+	for i := range exts {
+		exts[i] = C.GoString(names[i])
+	}
+	return exts, nil
+}
+
+// VulkanCreateSurface creates a Vulkan surface for the window.
+// instance is a VkInstance handle and the returned value is a
+// VkSurfaceKHR handle, so that any Vulkan binding may be used.
+func (w *Window) VulkanCreateSurface(instance uintptr) (uint64, error) {
+	var surface C.uint64_t
+	if C.vulkanCreateSurface(&w.w, C.uintptr_t(instance), &surface) == C.SDL_FALSE {
+		return 0, GetError()
+	}
+	return uint64(surface), nil
+}
+
+// VulkanDrawableSize returns the size of a window's Vulkan drawable in
+// pixels.  On high-DPI displays this may be larger than the window's Size.
+func (w *Window) VulkanDrawableSize() (int, int) {
+	var width, height C.int
+	C.SDL_Vulkan_GetDrawableSize(&w.w, &width, &height)
+	return int(width), int(height)
+}
@@ -0,0 +1,139 @@
+package sdl
+
+// #include "SDL.h"
+import "C"
+
+import (
+	"encoding/binary"
+	"io"
+	"unsafe"
+)
+
+// AudioMaxVolume is the maximum volume for MixAudioFormat.
+const AudioMaxVolume = C.SDL_MIX_MAXVOLUME
+
+// LoadWAV reads a WAVE file.  The returned data is in the returned
+// spec's format.
+func LoadWAV(path string) (*AudioSpec, []byte, error) {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+	cmode := C.CString("rb")
+	defer C.free(unsafe.Pointer(cmode))
+	rw := C.SDL_RWFromFile(cpath, cmode)
+	if rw == nil {
+		return nil, nil, GetError()
+	}
+	return loadWAV(rw)
+}
+
+// LoadWAVReader reads WAVE data from r.  The returned data is in the
+// returned spec's format.
+func LoadWAVReader(r io.Reader) (*AudioSpec, []byte, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	cdata := C.CBytes(data)
+	defer C.free(cdata)
+	rw := C.SDL_RWFromConstMem(cdata, C.int(len(data)))
+	if rw == nil {
+		return nil, nil, GetError()
+	}
+	return loadWAV(rw)
+}
+
+// loadWAV reads WAVE data from rw and closes it.  The audio data is
+// copied into Go memory and SDL's buffer is freed immediately.
+func loadWAV(rw *C.SDL_RWops) (*AudioSpec, []byte, error) {
+	var cspec C.SDL_AudioSpec
+	var buf *C.Uint8
+	var n C.Uint32
+	if C.SDL_LoadWAV_RW(rw, 1, &cspec, &buf, &n) == nil {
+		return nil, nil, GetError()
+	}
+	defer C.SDL_FreeWAV(buf)
+	spec := newAudioSpec(&cspec)
+	return &spec, C.GoBytes(unsafe.Pointer(buf), C.int(n)), nil
+}
+
+// SaveWAV writes data in spec's format to w as a WAVE file.  Only
+// little-endian and 8-bit formats can be stored in WAVE files.
+func SaveWAV(w io.Writer, spec *AudioSpec, data []byte) error {
+	const (
+		formatPCM   = 1
+		formatFloat = 3
+	)
+	if spec.Format.BitSize() > 8 && spec.Format.IsBigEndian() {
+		return Error("WAVE files cannot store big-endian audio")
+	}
+	if spec.Format == AudioS8 || (spec.Format.BitSize() > 8 && spec.Format.IsInt() && spec.Format.IsUnsigned()) {
+		return Error("WAVE files cannot store audio format " + spec.Format.String())
+	}
+	tag := uint16(formatPCM)
+	if spec.Format.IsFloat() {
+		tag = formatFloat
+	}
+	bytesPerSample := spec.Format.BitSize() / 8
+	blockAlign := int(spec.Channels) * bytesPerSample
+	// RIFF chunks are padded to an even size; the pad byte is not
+	// counted in the chunk's own size.
+	pad := len(data) % 2
+
+	header := struct {
+		RIFF          [4]byte
+		RIFFSize      uint32
+		WAVE          [4]byte
+		Fmt           [4]byte
+		FmtSize       uint32
+		FormatTag     uint16
+		Channels      uint16
+		SampleRate    uint32
+		ByteRate      uint32
+		BlockAlign    uint16
+		BitsPerSample uint16
+		Data          [4]byte
+		DataSize      uint32
+	}{
+		RIFF:          [4]byte{'R', 'I', 'F', 'F'},
+		RIFFSize:      uint32(36 + len(data) + pad),
+		WAVE:          [4]byte{'W', 'A', 'V', 'E'},
+		Fmt:           [4]byte{'f', 'm', 't', ' '},
+		FmtSize:       16,
+		FormatTag:     tag,
+		Channels:      uint16(spec.Channels),
+		SampleRate:    uint32(spec.Freq),
+		ByteRate:      uint32(spec.Freq * blockAlign),
+		BlockAlign:    uint16(blockAlign),
+		BitsPerSample: uint16(spec.Format.BitSize()),
+		Data:          [4]byte{'d', 'a', 't', 'a'},
+		DataSize:      uint32(len(data)),
+	}
+	if err := binary.Write(w, binary.LittleEndian, &header); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if pad != 0 {
+		_, err := w.Write([]byte{0})
+		return err
+	}
+	return nil
+}
+
+// MixAudioFormat mixes src into dst, both in the given format, at a
+// volume from 0 to AudioMaxVolume.  Only the overlapping length of the
+// two buffers is mixed.
+func MixAudioFormat(dst, src []byte, format AudioFormat, volume int) {
+	n := len(dst)
+	if len(src) < n {
+		n = len(src)
+	}
+	if n == 0 {
+		return
+	}
+	C.SDL_MixAudioFormat((*C.Uint8)(unsafe.Pointer(&dst[0])), (*C.Uint8)(unsafe.Pointer(&src[0])), C.SDL_AudioFormat(format), C.Uint32(n), C.int(volume))
+}
@@ -0,0 +1,41 @@
+package sdl
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSaveWAVRoundTrip(t *testing.T) {
+	tests := []struct {
+		spec AudioSpec
+		data []byte
+	}{
+		// An odd-length data chunk needs a pad byte.
+		{AudioSpec{Freq: 8000, Format: AudioU8, Channels: 1}, []byte{0x00, 0x80, 0xff}},
+		{AudioSpec{Freq: 22050, Format: AudioS16LSB, Channels: 2}, []byte{0x01, 0x02, 0x03, 0x04, 0xfd, 0xfe, 0xff, 0x7f}},
+		{AudioSpec{Freq: 48000, Format: AudioF32LSB, Channels: 1}, []byte{0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x80, 0xbf}},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := SaveWAV(&buf, &test.spec, test.data); err != nil {
+			t.Errorf("SaveWAV(%v): %v", test.spec.Format, err)
+			continue
+		}
+		if buf.Len()%2 != 0 {
+			t.Errorf("SaveWAV(%v) wrote %d bytes; want an even size", test.spec.Format, buf.Len())
+		}
+		spec, data, err := LoadWAVReader(&buf)
+		if err != nil {
+			t.Errorf("LoadWAVReader(SaveWAV(%v)): %v", test.spec.Format, err)
+			continue
+		}
+		if spec.Freq != test.spec.Freq || spec.Format != test.spec.Format || spec.Channels != test.spec.Channels {
+			t.Errorf("LoadWAVReader(SaveWAV(%v)) spec = %d Hz %v %d channels; want %d Hz %v %d channels",
+				test.spec.Format, spec.Freq, spec.Format, spec.Channels,
+				test.spec.Freq, test.spec.Format, test.spec.Channels)
+		}
+		if !bytes.Equal(data, test.data) {
+			t.Errorf("LoadWAVReader(SaveWAV(%v)) data = %x; want %x", test.spec.Format, data, test.data)
+		}
+	}
+}
@@ -1,6 +1,29 @@
 package sdl
 
 // #include "SDL.h"
+//
+// static void setWindowResizable(SDL_Window *w, SDL_bool resizable) {
+// #if SDL_VERSION_ATLEAST(2,0,5)
+// 	SDL_SetWindowResizable(w, resizable);
+// #endif
+// }
+//
+// static int setWindowOpacity(SDL_Window *w, float opacity) {
+// #if SDL_VERSION_ATLEAST(2,0,5)
+// 	return SDL_SetWindowOpacity(w, opacity);
+// #else
+// 	return SDL_Unsupported();
+// #endif
+// }
+//
+// static int getWindowOpacity(SDL_Window *w, float *opacity) {
+// #if SDL_VERSION_ATLEAST(2,0,5)
+// 	return SDL_GetWindowOpacity(w, opacity);
+// #else
+// 	*opacity = 1.0f;
+// 	return 0;
+// #endif
+// }
 import "C"
 
 import (
@@ -71,6 +94,12 @@ func NewWindow(title string, r Rectangle, flags ...WindowFlag) (*Window, error)
 	return (*Window)(unsafe.Pointer(w)), nil
 }
 
+// WindowFromID returns the window with the given ID or nil if no such
+// window exists.
+func WindowFromID(id uint32) *Window {
+	return (*Window)(unsafe.Pointer(C.SDL_GetWindowFromID(C.Uint32(id))))
+}
+
 // Surface returns the window's surface.
 func (w *Window) Surface() *Surface {
 	return (*Surface)(unsafe.Pointer(C.SDL_GetWindowSurface(&w.w)))
@@ -92,6 +121,26 @@ func (w *Window) CreateRenderer(driverIndex int, flags ...RendererFlag) (*Render
 	return (*Renderer)(unsafe.Pointer(r)), nil
 }
 
+// CreateRendererByName creates a 2D rendering context for a window
+// using the rendering driver with the given name, like "software" or
+// "opengl".  Multiple flags will be ORed together.
+func (w *Window) CreateRendererByName(name string, flags ...RendererFlag) (*Renderer, error) {
+	n, err := NumRenderDrivers()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		info, err := RenderDriverInfo(i)
+		if err != nil {
+			return nil, err
+		}
+		if info.Name == name {
+			return w.CreateRenderer(i, flags...)
+		}
+	}
+	return nil, Error("no render driver named " + name)
+}
+
 // Renderer returns the window's renderer or nil if it doesn't have one.
 func (w *Window) Renderer() *Renderer {
 	return (*Renderer)(unsafe.Pointer(C.SDL_GetRenderer(&w.w)))
@@ -105,6 +154,156 @@ func (w *Window) Size() (int, int) {
 	return int(width), int(height)
 }
 
+// SetSize sets the size of the window's client area, in pixels.
+func (w *Window) SetSize(width, height int) {
+	C.SDL_SetWindowSize(&w.w, C.int(width), C.int(height))
+}
+
+// MinimumSize returns the minimum size of the window's client area.
+func (w *Window) MinimumSize() (int, int) {
+	var width, height C.int
+	C.SDL_GetWindowMinimumSize(&w.w, &width, &height)
+	return int(width), int(height)
+}
+
+// SetMinimumSize sets the minimum size of the window's client area.
+func (w *Window) SetMinimumSize(width, height int) {
+	C.SDL_SetWindowMinimumSize(&w.w, C.int(width), C.int(height))
+}
+
+// MaximumSize returns the maximum size of the window's client area.
+func (w *Window) MaximumSize() (int, int) {
+	var width, height C.int
+	C.SDL_GetWindowMaximumSize(&w.w, &width, &height)
+	return int(width), int(height)
+}
+
+// SetMaximumSize sets the maximum size of the window's client area.
+func (w *Window) SetMaximumSize(width, height int) {
+	C.SDL_SetWindowMaximumSize(&w.w, C.int(width), C.int(height))
+}
+
+// Title returns the window's title.
+func (w *Window) Title() string {
+	return C.GoString(C.SDL_GetWindowTitle(&w.w))
+}
+
+// SetTitle sets the window's title.
+func (w *Window) SetTitle(title string) {
+	ctitle := C.CString(title)
+	defer C.free(unsafe.Pointer(ctitle))
+	C.SDL_SetWindowTitle(&w.w, ctitle)
+}
+
+// Position returns the position of the window's upper left corner.
+func (w *Window) Position() (int, int) {
+	var x, y C.int
+	C.SDL_GetWindowPosition(&w.w, &x, &y)
+	return int(x), int(y)
+}
+
+// SetPosition moves the window.  x or y may also be
+// WindowPosCentered or WindowPosUndefined.
+func (w *Window) SetPosition(x, y int) {
+	C.SDL_SetWindowPosition(&w.w, C.int(x), C.int(y))
+}
+
+// Flags returns the window's current flags.
+func (w *Window) Flags() WindowFlag {
+	return WindowFlag(C.SDL_GetWindowFlags(&w.w))
+}
+
+// ID returns the window's numeric ID, as used in window events.
+func (w *Window) ID() uint32 {
+	return uint32(C.SDL_GetWindowID(&w.w))
+}
+
+// SetFullscreen changes the window's fullscreen state.  flag must be
+// WindowFullscreen, WindowFullscreenDesktop or zero for windowed mode.
+func (w *Window) SetFullscreen(flag WindowFlag) error {
+	if C.SDL_SetWindowFullscreen(&w.w, C.Uint32(flag)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// Show shows the window.
+func (w *Window) Show() {
+	C.SDL_ShowWindow(&w.w)
+}
+
+// Hide hides the window.
+func (w *Window) Hide() {
+	C.SDL_HideWindow(&w.w)
+}
+
+// Raise raises the window above other windows and requests input focus.
+func (w *Window) Raise() {
+	C.SDL_RaiseWindow(&w.w)
+}
+
+// Minimize minimizes the window to an iconic representation.
+func (w *Window) Minimize() {
+	C.SDL_MinimizeWindow(&w.w)
+}
+
+// Maximize makes the window as large as possible.
+func (w *Window) Maximize() {
+	C.SDL_MaximizeWindow(&w.w)
+}
+
+// Restore restores the size and position of a minimized or maximized window.
+func (w *Window) Restore() {
+	C.SDL_RestoreWindow(&w.w)
+}
+
+// SetBordered adds or removes the window's border.  This has no effect
+// on fullscreen windows.
+func (w *Window) SetBordered(bordered bool) {
+	C.SDL_SetWindowBordered(&w.w, cBool(bordered))
+}
+
+// SetResizable sets whether the user can resize the window.  This has
+// no effect on fullscreen windows or before SDL 2.0.5.
+func (w *Window) SetResizable(resizable bool) {
+	C.setWindowResizable(&w.w, cBool(resizable))
+}
+
+// Brightness returns the brightness (gamma multiplier) of the
+// display that owns the window.
+func (w *Window) Brightness() float32 {
+	return float32(C.SDL_GetWindowBrightness(&w.w))
+}
+
+// SetBrightness sets the brightness (gamma multiplier) of the display
+// that owns the window, where 0.0 is completely dark and 1.0 is normal.
+func (w *Window) SetBrightness(brightness float32) error {
+	if C.SDL_SetWindowBrightness(&w.w, C.float(brightness)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+// Opacity returns the window's opacity.  Windows are always opaque
+// before SDL 2.0.5.
+func (w *Window) Opacity() (float32, error) {
+	var opacity C.float
+	if C.getWindowOpacity(&w.w, &opacity) != 0 {
+		return 0, GetError()
+	}
+	return float32(opacity), nil
+}
+
+// SetOpacity sets the window's opacity, where 0.0 is transparent and
+// 1.0 is opaque.  It requires SDL 2.0.5 or later and platform support,
+// and returns an error otherwise.
+func (w *Window) SetOpacity(opacity float32) error {
+	if C.setWindowOpacity(&w.w, C.float(opacity)) != 0 {
+		return GetError()
+	}
+	return nil
+}
+
 // Destroy destroys a window.  It is not safe to use the window after
 // calling Destroy.
 func (w *Window) Destroy() {
@@ -137,7 +336,30 @@ func (r *Renderer) Info() (*RendererInfo, error) {
 	if C.SDL_GetRendererInfo(&r.r, &info) != 0 {
 		return nil, GetError()
 	}
+	return newRendererInfo(&info), nil
+}
 
+// NumRenderDrivers returns the number of 2D rendering drivers
+// available for the current display.
+func NumRenderDrivers() (int, error) {
+	n := C.SDL_GetNumRenderDrivers()
+	if n < 0 {
+		return 0, GetError()
+	}
+	return int(n), nil
+}
+
+// RenderDriverInfo returns the capabilities of the rendering driver at
+// the given index, which is in the range [0, NumRenderDrivers()).
+func RenderDriverInfo(index int) (*RendererInfo, error) {
+	var info C.SDL_RendererInfo
+	if C.SDL_GetRenderDriverInfo(C.int(index), &info) != 0 {
+		return nil, GetError()
+	}
+	return newRendererInfo(&info), nil
+}
+
+func newRendererInfo(info *C.SDL_RendererInfo) *RendererInfo {
 	formats := make([]PixelFormatEnum, info.num_texture_formats)
 	for i := range formats {
 		formats[i] = PixelFormatEnum(info.texture_formats[i])
@@ -148,7 +370,7 @@ func (r *Renderer) Info() (*RendererInfo, error) {
 		TextureFormats:   formats,
 		MaxTextureWidth:  int(info.max_texture_width),
 		MaxTextureHeight: int(info.max_texture_height),
-	}, nil
+	}
 }
 
 // CopyTexture copies a portion of the texture to the current rendering context.
@@ -160,6 +382,7 @@ func (r *Renderer) CopyTexture(texture *Texture, srcRect, destRect *Rectangle) e
 }
 
 // Clear clears the current rendering target with the drawing color.
+// See SetDrawColor.
 func (r *Renderer) Clear() error {
 	if C.SDL_RenderClear(&r.r) != 0 {
 		return GetError()